@@ -41,4 +41,9 @@ const (
 	IntOperatorName            = "__ціле__"
 	StringOperatorName         = "__рядок__"
 	RepresentationOperatorName = "__представлення__"
+	ContainsOperatorName       = "__містить__"
+	IteratorOperatorName       = "__ітератор__"
+	NextOperatorName           = "__наступний__"
+	CopyOperatorName           = "__копія__"
+	DeepCopyOperatorName       = "__глибока_копія__"
 )
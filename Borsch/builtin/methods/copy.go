@@ -0,0 +1,47 @@
+package methods
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+func MakeCopy(pkg *types.Package) *types.Method {
+	return types.FunctionNew(
+		"копія", pkg, []types.MethodParameter{
+			{
+				Class:      types.ObjectClass,
+				Name:       "о",
+				IsNullable: true,
+				IsVariadic: false,
+			},
+		},
+		[]types.MethodReturnType{
+			{
+				Class:      types.ObjectClass,
+				IsNullable: true,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			return types.Copy(ctx, args[0])
+		},
+	)
+}
+
+func MakeDeepCopy(pkg *types.Package) *types.Method {
+	return types.FunctionNew(
+		"глибока_копія", pkg, []types.MethodParameter{
+			{
+				Class:      types.ObjectClass,
+				Name:       "о",
+				IsNullable: true,
+				IsVariadic: false,
+			},
+		},
+		[]types.MethodReturnType{
+			{
+				Class:      types.ObjectClass,
+				IsNullable: true,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			return types.DeepCopy(ctx, args[0])
+		},
+	)
+}
@@ -0,0 +1,33 @@
+package methods
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+// MakeEnumerate creates 'пронумерувати()', which lazily gives tuples of
+// the number, starting from the optional start or 0, and the element.
+func MakeEnumerate(pkg *types.Package) *types.Method {
+	return types.FunctionNew(
+		"пронумерувати", pkg, []types.MethodParameter{
+			objectParameter("ітерований", false),
+			objectParameter("початок", true),
+		},
+		iteratorReturnType(),
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			optional, err := optionalArgs("пронумерувати", args[1], 1)
+			if err != nil {
+				return nil, err
+			}
+
+			start := types.Int(0)
+			if len(optional) != 0 {
+				var ok bool
+				if start, ok = optional[0].(types.Int); !ok {
+					return nil, types.NewTypeErrorf(
+						"початок має бути типу '%s', отримано '%s'", types.IntClass.Name, optional[0].Class().Name,
+					)
+				}
+			}
+
+			return types.NewEnumerateIterator(ctx, args[0], start)
+		},
+	)
+}
@@ -0,0 +1,19 @@
+package methods
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+// MakeFilter creates 'фільтрувати()', which lazily gives elements of the
+// iterable, for which the function returns the true value. If the
+// function is нуль, elements are checked themselves.
+func MakeFilter(pkg *types.Package) *types.Method {
+	return types.FunctionNew(
+		"фільтрувати", pkg, []types.MethodParameter{
+			objectParameter("функція", false),
+			objectParameter("ітерований", false),
+		},
+		iteratorReturnType(),
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			return types.NewFilterIterator(ctx, args[0], args[1])
+		},
+	)
+}
@@ -0,0 +1,31 @@
+package methods
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+func MakeFormat(pkg *types.Package) *types.Method {
+	return types.FunctionNew(
+		"формат", pkg, []types.MethodParameter{
+			{
+				Class:      types.ObjectClass,
+				Name:       "значення",
+				IsNullable: true,
+				IsVariadic: false,
+			},
+			{
+				Class:      types.StringClass,
+				Name:       "специфікатор",
+				IsNullable: false,
+				IsVariadic: false,
+			},
+		},
+		[]types.MethodReturnType{
+			{
+				Class:      types.StringClass,
+				IsNullable: false,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			return types.Format(ctx, args[0], string(args[1].(types.String)))
+		},
+	)
+}
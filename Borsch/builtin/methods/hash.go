@@ -0,0 +1,25 @@
+package methods
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+func MakeHash(pkg *types.Package) *types.Method {
+	return types.FunctionNew(
+		"хеш", pkg, []types.MethodParameter{
+			{
+				Class:      types.ObjectClass,
+				Name:       "о",
+				IsNullable: true,
+				IsVariadic: false,
+			},
+		},
+		[]types.MethodReturnType{
+			{
+				Class:      types.IntClass,
+				IsNullable: false,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			return types.Hash(ctx, args[0])
+		},
+	)
+}
@@ -0,0 +1,29 @@
+package methods
+
+import (
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+)
+
+// MakeInput creates 'ввести()', which prints the prompt and reads a line
+// from the standard input. 'нуль' is returned at the end of the input.
+func MakeInput(pkg *types.Package) *types.Method {
+	return types.FunctionNew(
+		"ввести", pkg, []types.MethodParameter{
+			{
+				Class:      types.StringClass,
+				Name:       "підказка",
+				IsNullable: false,
+				IsVariadic: false,
+			},
+		},
+		[]types.MethodReturnType{
+			{
+				Class:      types.StringClass,
+				IsNullable: true,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			return types.Input(ctx, string(args[0].(types.String)))
+		},
+	)
+}
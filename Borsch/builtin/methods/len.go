@@ -20,8 +20,8 @@ func MakeLen(pkg *types.Package) *types.Method {
 		},
 		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
 			arg0 := args[0]
-			if seq, ok := arg0.(types.ISequence); ok {
-				return seq.Length(ctx)
+			if container, ok := arg0.(types.ISized); ok {
+				return container.Length(ctx)
 			}
 
 			return nil, types.NewTypeErrorf("функція 'довжина' не підтримує об'єкти з типом %s", arg0.Class().Name)
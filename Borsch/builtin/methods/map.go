@@ -0,0 +1,23 @@
+package methods
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+// MakeMap creates 'відобразити()', which lazily calls the function with
+// elements of the iterables taken at the same position.
+func MakeMap(pkg *types.Package) *types.Method {
+	return types.FunctionNew(
+		"відобразити", pkg, []types.MethodParameter{
+			objectParameter("функція", false),
+			objectParameter("ітеровані", true),
+		},
+		iteratorReturnType(),
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			iterables := *args[1].(*types.Tuple)
+			if len(iterables) == 0 {
+				return nil, types.NewTypeErrorf("функція 'відобразити' потребує хоча б один ітерований об'єкт")
+			}
+
+			return types.NewMapIterator(ctx, args[0], iterables)
+		},
+	)
+}
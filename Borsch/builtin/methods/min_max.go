@@ -0,0 +1,80 @@
+package methods
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+// MakeMin creates 'мін()', which returns the smallest element of the
+// iterable compared by the optional key function.
+func MakeMin(pkg *types.Package) *types.Method {
+	return makeExtremum(pkg, "мін", types.Less)
+}
+
+// MakeMax creates 'макс()', which returns the largest element of the
+// iterable compared by the optional key function.
+func MakeMax(pkg *types.Package) *types.Method {
+	return makeExtremum(pkg, "макс", types.Greater)
+}
+
+// makeExtremum creates the function, which returns the first element,
+// the key of which is better than keys of other elements by compare.
+func makeExtremum(
+	pkg *types.Package,
+	name string,
+	compare func(ctx types.Context, a, b types.Object) (types.Object, error),
+) *types.Method {
+	return types.FunctionNew(
+		name, pkg, []types.MethodParameter{
+			objectParameter("ітерований", false),
+			objectParameter("ключ", true),
+		},
+		[]types.MethodReturnType{
+			{
+				Class:      types.ObjectClass,
+				IsNullable: true,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			optional, err := optionalArgs(name, args[1], 1)
+			if err != nil {
+				return nil, err
+			}
+
+			key, err := keyArg(optional, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			elements, err := elementsOf(ctx, args[0])
+			if err != nil {
+				return nil, err
+			}
+
+			if len(elements) == 0 {
+				return nil, types.NewValueErrorf("функція '%s' отримала порожній ітерований об'єкт", name)
+			}
+
+			keys, err := types.Keys(ctx, elements, key)
+			if err != nil {
+				return nil, err
+			}
+
+			best := 0
+			for i := 1; i < len(elements); i++ {
+				better, err := compare(ctx, keys[i], keys[best])
+				if err != nil {
+					return nil, err
+				}
+
+				ok, err := types.ToBool(ctx, better)
+				if err != nil {
+					return nil, err
+				}
+
+				if ok.(types.Bool) {
+					best = i
+				}
+			}
+
+			return elements[best], nil
+		},
+	)
+}
@@ -0,0 +1,64 @@
+package methods
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+func objectParameter(name string, isVariadic bool) types.MethodParameter {
+	return types.MethodParameter{
+		Class:      types.ObjectClass,
+		Name:       name,
+		IsNullable: true,
+		IsVariadic: isVariadic,
+	}
+}
+
+// optionalArgs unpacks the variadic argument, which holds at most max
+// optional arguments of the function.
+func optionalArgs(name string, arg types.Object, max int) (types.Tuple, error) {
+	args := *arg.(*types.Tuple)
+	if len(args) > max {
+		return nil, types.NewTypeErrorf(
+			"функція '%s' приймає не більше %d необов'язкових аргументів (отримано %d)", name, max, len(args),
+		)
+	}
+
+	return args, nil
+}
+
+// keyArg returns the optional key function, which is нуль if it is not
+// given.
+func keyArg(args types.Tuple, index int) (types.Object, error) {
+	if index >= len(args) || args[index] == types.Nil {
+		return types.Nil, nil
+	}
+
+	if _, ok := args[index].(types.ICall); !ok {
+		return nil, types.NewTypeErrorf("ключ має бути функцією або нулем, отримано '%s'", args[index].Class().Name)
+	}
+
+	return args[index], nil
+}
+
+// elementsOf collects elements of the iterable.
+func elementsOf(ctx types.Context, iterable types.Object) ([]types.Object, error) {
+	var elements []types.Object
+	err := types.IterateOver(
+		ctx, iterable, func(element types.Object) (bool, error) {
+			elements = append(elements, element)
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return elements, nil
+}
+
+func iteratorReturnType() []types.MethodReturnType {
+	return []types.MethodReturnType{
+		{
+			Class:      types.IteratorClass,
+			IsNullable: false,
+		},
+	}
+}
@@ -0,0 +1,86 @@
+package methods
+
+import (
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+)
+
+// MakePrint creates 'друк()', which prints its arguments separated by
+// spaces and followed by a line break to the standard output.
+func MakePrint(pkg *types.Package) *types.Method {
+	return types.FunctionNew(
+		"друк", pkg, []types.MethodParameter{
+			{
+				Class:      types.ObjectClass,
+				Name:       "значення",
+				IsNullable: true,
+				IsVariadic: true,
+			},
+		},
+		[]types.MethodReturnType{
+			{
+				Class:      types.NilClass,
+				IsNullable: true,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			if err := types.Print(ctx, types.Stdout, *args[0].(*types.Tuple), " ", "\n"); err != nil {
+				return nil, err
+			}
+
+			return types.Nil, nil
+		},
+	)
+}
+
+// MakePrintTo creates 'друк_у()', which prints its arguments to the file
+// with the given separator and ending, i.e. to 'стд_помилки'.
+func MakePrintTo(pkg *types.Package) *types.Method {
+	return types.FunctionNew(
+		"друк_у", pkg, []types.MethodParameter{
+			{
+				Class:      types.FileClass,
+				Name:       "файл",
+				IsNullable: false,
+				IsVariadic: false,
+			},
+			{
+				Class:      types.StringClass,
+				Name:       "роздільник",
+				IsNullable: false,
+				IsVariadic: false,
+			},
+			{
+				Class:      types.StringClass,
+				Name:       "кінець",
+				IsNullable: false,
+				IsVariadic: false,
+			},
+			{
+				Class:      types.ObjectClass,
+				Name:       "значення",
+				IsNullable: true,
+				IsVariadic: true,
+			},
+		},
+		[]types.MethodReturnType{
+			{
+				Class:      types.NilClass,
+				IsNullable: true,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			err := types.Print(
+				ctx,
+				args[0].(*types.File),
+				*args[3].(*types.Tuple),
+				string(args[1].(types.String)),
+				string(args[2].(types.String)),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return types.Nil, nil
+		},
+	)
+}
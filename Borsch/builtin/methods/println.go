@@ -1,8 +1,6 @@
 package methods
 
 import (
-	"fmt"
-
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
 )
 
@@ -23,12 +21,10 @@ func MakePrintln(pkg *types.Package) *types.Method {
 			},
 		},
 		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
-			message, err := types.ToGoString(ctx, args[0])
-			if err != nil {
+			if err := types.Print(ctx, types.Stdout, args, "", "\n"); err != nil {
 				return nil, err
 			}
 
-			fmt.Println(message)
 			return types.Nil, nil
 		},
 	)
@@ -0,0 +1,55 @@
+package methods
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+// MakeReduce creates 'згорнути()', which combines elements of the
+// iterable from left to right with the function of two arguments,
+// starting from the optional initial value.
+func MakeReduce(pkg *types.Package) *types.Method {
+	return types.FunctionNew(
+		"згорнути", pkg, []types.MethodParameter{
+			objectParameter("функція", false),
+			objectParameter("ітерований", false),
+			objectParameter("початкове", true),
+		},
+		[]types.MethodReturnType{
+			{
+				Class:      types.ObjectClass,
+				IsNullable: true,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			optional, err := optionalArgs("згорнути", args[2], 1)
+			if err != nil {
+				return nil, err
+			}
+
+			var result types.Object
+			if len(optional) != 0 {
+				result = optional[0]
+			}
+
+			err = types.IterateOver(
+				ctx, args[1], func(element types.Object) (bool, error) {
+					if result == nil {
+						result = element
+						return false, nil
+					}
+
+					var err error
+					result, err = types.Call(ctx, args[0], types.Tuple{result, element})
+					return false, err
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if result == nil {
+				return nil, types.NewValueErrorf("неможливо згорнути порожній ітерований об'єкт без початкового значення")
+			}
+
+			return result, nil
+		},
+	)
+}
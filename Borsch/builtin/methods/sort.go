@@ -0,0 +1,56 @@
+package methods
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+// MakeSorted creates 'сортувати()', which returns the new list of
+// elements of the iterable stably sorted by the optional key function,
+// in the descending order if the optional flag is істина.
+func MakeSorted(pkg *types.Package) *types.Method {
+	return types.FunctionNew(
+		"сортувати", pkg, []types.MethodParameter{
+			objectParameter("ітерований", false),
+			objectParameter("ключ_і_обернено", true),
+		},
+		[]types.MethodReturnType{
+			{
+				Class:      types.ListClass,
+				IsNullable: false,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			optional, err := optionalArgs("сортувати", args[1], 2)
+			if err != nil {
+				return nil, err
+			}
+
+			key, err := keyArg(optional, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			reverse := types.Bool(false)
+			if len(optional) == 2 {
+				var ok bool
+				if reverse, ok = optional[1].(types.Bool); !ok {
+					return nil, types.NewTypeErrorf(
+						"обернено має бути типу '%s', отримано '%s'", types.BoolClass.Name, optional[1].Class().Name,
+					)
+				}
+			}
+
+			elements, err := elementsOf(ctx, args[0])
+			if err != nil {
+				return nil, err
+			}
+
+			sorted, err := types.SortByKey(ctx, elements, key, bool(reverse))
+			if err != nil {
+				return nil, err
+			}
+
+			list := types.NewList()
+			list.Values = sorted
+			return list, nil
+		},
+	)
+}
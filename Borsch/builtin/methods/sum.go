@@ -0,0 +1,44 @@
+package methods
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+// MakeSum creates 'сума()', which adds elements of the iterable to the
+// optional start or 0.
+func MakeSum(pkg *types.Package) *types.Method {
+	return types.FunctionNew(
+		"сума", pkg, []types.MethodParameter{
+			objectParameter("ітерований", false),
+			objectParameter("початок", true),
+		},
+		[]types.MethodReturnType{
+			{
+				Class:      types.ObjectClass,
+				IsNullable: true,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			optional, err := optionalArgs("сума", args[1], 1)
+			if err != nil {
+				return nil, err
+			}
+
+			var result types.Object = types.Int(0)
+			if len(optional) != 0 {
+				result = optional[0]
+			}
+
+			err = types.IterateOver(
+				ctx, args[0], func(element types.Object) (bool, error) {
+					var err error
+					result, err = types.Add(ctx, result, element)
+					return false, err
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return result, nil
+		},
+	)
+}
@@ -0,0 +1,18 @@
+package methods
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+// MakeZip creates 'зіпнути()', which lazily gives tuples of elements of
+// the iterables taken at the same position until the shortest one is
+// exhausted.
+func MakeZip(pkg *types.Package) *types.Method {
+	return types.FunctionNew(
+		"зіпнути", pkg, []types.MethodParameter{
+			objectParameter("ітеровані", true),
+		},
+		iteratorReturnType(),
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			return types.NewZipIterator(ctx, *args[0].(*types.Tuple))
+		},
+	)
+}
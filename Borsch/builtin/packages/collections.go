@@ -0,0 +1,16 @@
+package packages
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+const CollectionsPackageName = "колекції"
+
+func MakeCollections(_ *types.Package) types.StringDict {
+	return types.StringDict{
+		types.DequeClass.Name:         types.DequeClass,
+		types.QueueClass.Name:         types.QueueClass,
+		types.PriorityQueueClass.Name: types.PriorityQueueClass,
+		types.OrderedDictClass.Name:   types.OrderedDictClass,
+		types.CounterClass.Name:       types.CounterClass,
+		types.DefaultDictClass.Name:   types.DefaultDictClass,
+	}
+}
@@ -0,0 +1,186 @@
+package packages
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+)
+
+const ComplexMathPackageName = "математика/комплексна"
+
+type complexFunc func(z complex128) (complex128, error)
+
+func complexArgument(ctx types.Context, arg types.Object) (complex128, error) {
+	z, err := types.ToComplex(ctx, arg)
+	if err != nil {
+		return 0, err
+	}
+
+	return complex128(z.(types.Complex)), nil
+}
+
+func numberParameter(name string) types.MethodParameter {
+	return types.MethodParameter{
+		Class:      types.ObjectClass,
+		Name:       name,
+		IsNullable: false,
+		IsVariadic: false,
+	}
+}
+
+func newComplexFunction(pkg *types.Package, name string, returnType *types.Class, f func(z complex128) (types.Object, error)) *types.Method {
+	return types.FunctionNew(
+		name, pkg, []types.MethodParameter{numberParameter("z")},
+		[]types.MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: false,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			z, err := complexArgument(ctx, args[0])
+			if err != nil {
+				return nil, err
+			}
+
+			return f(z)
+		},
+	)
+}
+
+func wrapComplexFunc(f complexFunc) func(z complex128) (types.Object, error) {
+	return func(z complex128) (types.Object, error) {
+		result, err := f(z)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.Complex(result), nil
+	}
+}
+
+func simpleComplexFunc(f func(z complex128) complex128) complexFunc {
+	return func(z complex128) (complex128, error) {
+		return f(z), nil
+	}
+}
+
+// logarithm returns the function which raises ValueError for zero
+// instead of returning infinity.
+func logarithm(f func(z complex128) complex128) complexFunc {
+	return func(z complex128) (complex128, error) {
+		if z == 0 {
+			return 0, types.NewValueErrorf("логарифм нуля не визначений")
+		}
+
+		return f(z), nil
+	}
+}
+
+// MakeComplexMath creates functions and constants of the complex
+// mathematics package. The functions accept any numbers and return
+// complex numbers.
+func MakeComplexMath(pkg *types.Package) types.StringDict {
+	complexFunctions := map[string]complexFunc{
+		"корінь":                simpleComplexFunc(cmplx.Sqrt),
+		"експонента":            simpleComplexFunc(cmplx.Exp),
+		"логарифм":              logarithm(cmplx.Log),
+		"логарифм10":            logarithm(cmplx.Log10),
+		"синус":                 simpleComplexFunc(cmplx.Sin),
+		"косинус":               simpleComplexFunc(cmplx.Cos),
+		"тангенс":               simpleComplexFunc(cmplx.Tan),
+		"арксинус":              simpleComplexFunc(cmplx.Asin),
+		"арккосинус":            simpleComplexFunc(cmplx.Acos),
+		"арктангенс":            simpleComplexFunc(cmplx.Atan),
+		"гіперболічний_синус":   simpleComplexFunc(cmplx.Sinh),
+		"гіперболічний_косинус": simpleComplexFunc(cmplx.Cosh),
+		"гіперболічний_тангенс": simpleComplexFunc(cmplx.Tanh),
+	}
+
+	dict := types.StringDict{
+		"пі": types.Real(math.Pi),
+		"е":  types.Real(math.E),
+	}
+
+	for name, f := range complexFunctions {
+		dict[name] = newComplexFunction(pkg, name, types.ComplexClass, wrapComplexFunc(f))
+	}
+
+	realFunctions := []*types.Method{
+		newComplexFunction(
+			pkg, "модуль", types.RealClass, func(z complex128) (types.Object, error) {
+				return types.Real(cmplx.Abs(z)), nil
+			},
+		),
+		newComplexFunction(
+			pkg, "фаза", types.RealClass, func(z complex128) (types.Object, error) {
+				return types.Real(cmplx.Phase(z)), nil
+			},
+		),
+		newComplexFunction(
+			pkg, "є_нескінченним", types.BoolClass, func(z complex128) (types.Object, error) {
+				return types.NewBool(cmplx.IsInf(z)), nil
+			},
+		),
+		newComplexFunction(
+			pkg, "є_нечислом", types.BoolClass, func(z complex128) (types.Object, error) {
+				return types.NewBool(cmplx.IsNaN(z)), nil
+			},
+		),
+	}
+
+	for _, function := range realFunctions {
+		dict[function.Name] = function
+	}
+
+	polar := types.FunctionNew(
+		"полярні", pkg, []types.MethodParameter{numberParameter("z")},
+		[]types.MethodReturnType{
+			{
+				Class:      types.RealClass,
+				IsNullable: false,
+			},
+			{
+				Class:      types.RealClass,
+				IsNullable: false,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			z, err := complexArgument(ctx, args[0])
+			if err != nil {
+				return nil, err
+			}
+
+			r, phase := cmplx.Polar(z)
+			return &types.Tuple{types.Real(r), types.Real(phase)}, nil
+		},
+	)
+	dict[polar.Name] = polar
+
+	rect := types.FunctionNew(
+		"з_полярних", pkg, []types.MethodParameter{numberParameter("r"), numberParameter("фаза")},
+		[]types.MethodReturnType{
+			{
+				Class:      types.ComplexClass,
+				IsNullable: false,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			r, err := types.ToReal(ctx, args[0])
+			if err != nil {
+				return nil, err
+			}
+
+			phase, err := types.ToReal(ctx, args[1])
+			if err != nil {
+				return nil, err
+			}
+
+			return types.Complex(cmplx.Rect(float64(r.(types.Real)), float64(phase.(types.Real)))), nil
+		},
+	)
+	dict[rect.Name] = rect
+
+	return dict
+}
@@ -0,0 +1,380 @@
+package packages
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+)
+
+const (
+	FilesPackageName = "файли"
+	PathPackageName  = "файли/шлях"
+)
+
+func goString(arg types.Object) string {
+	return string(arg.(types.String))
+}
+
+// newPathFunction creates a function of the single path parameter.
+func newPathFunction(
+	pkg *types.Package,
+	name string,
+	returnType *types.Class,
+	f func(ctx types.Context, path string) (types.Object, error),
+) *types.Method {
+	return newFunction(
+		pkg, name, []types.MethodParameter{stringParameter("шлях")}, returnType,
+		func(ctx types.Context, args types.Tuple) (types.Object, error) {
+			return f(ctx, goString(args[0]))
+		},
+	)
+}
+
+// newPathAction creates a function of the single path parameter,
+// which returns nil and converts Go errors to IOError.
+func newPathAction(pkg *types.Package, name string, action func(path string) error) *types.Method {
+	return newPathFunction(
+		pkg, name, types.NilClass, func(_ types.Context, path string) (types.Object, error) {
+			if err := action(path); err != nil {
+				return nil, types.IOErrorFromGo(err)
+			}
+
+			return types.Nil, nil
+		},
+	)
+}
+
+func newStatPredicate(pkg *types.Package, name string, predicate func(info os.FileInfo) bool) *types.Method {
+	return newPathFunction(
+		pkg, name, types.BoolClass, func(_ types.Context, path string) (types.Object, error) {
+			info, err := os.Stat(path)
+			if err != nil {
+				if os.IsNotExist(err) {
+					return types.False, nil
+				}
+
+				return nil, types.IOErrorFromGo(err)
+			}
+
+			return types.NewBool(predicate(info)), nil
+		},
+	)
+}
+
+// newWriteFunction creates a function, which writes the data to the
+// file opened with the flags.
+func newWriteFunction(pkg *types.Package, name string, flag int, binary bool) *types.Method {
+	parameter := stringParameter("текст")
+	if binary {
+		parameter = numberParameter("дані")
+	}
+
+	return newFunction(
+		pkg, name, []types.MethodParameter{stringParameter("шлях"), parameter}, types.NilClass,
+		func(ctx types.Context, args types.Tuple) (types.Object, error) {
+			var data []byte
+			if binary {
+				var err error
+				if data, err = types.BytesFromObject(ctx, args[1]); err != nil {
+					return nil, err
+				}
+			} else {
+				data = []byte(goString(args[1]))
+			}
+
+			file, err := os.OpenFile(goString(args[0]), flag, 0666)
+			if err != nil {
+				return nil, types.IOErrorFromGo(err)
+			}
+
+			_, err = file.Write(data)
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+
+			if err != nil {
+				return nil, types.IOErrorFromGo(err)
+			}
+
+			return types.Nil, nil
+		},
+	)
+}
+
+func readText(path string) (string, error) {
+	file, err := types.FileOpen(path, "ч")
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+	text, err := file.Read()
+	if err != nil {
+		return "", err
+	}
+
+	return goString(text), nil
+}
+
+func fileInfo(ctx types.Context, path string) (types.Object, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, types.IOErrorFromGo(err)
+	}
+
+	modTime := info.ModTime()
+	attributes := []struct {
+		name  string
+		value types.Object
+	}{
+		{"ім_я", types.String(info.Name())},
+		{"розмір", types.Int(info.Size())},
+		{"є_каталогом", types.NewBool(info.IsDir())},
+		{"права", types.Int(info.Mode().Perm())},
+		{"час_зміни", types.Real(float64(modTime.UnixNano()) / 1e9)},
+	}
+
+	dict := types.NewDictionary()
+	for _, attribute := range attributes {
+		if _, err := dict.SetItem(ctx, types.String(attribute.name), attribute.value); err != nil {
+			return nil, err
+		}
+	}
+
+	return dict, nil
+}
+
+func listDirectory(_ types.Context, path string) (types.Object, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, types.IOErrorFromGo(err)
+	}
+
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Name()
+	}
+
+	sort.Strings(names)
+	list := types.NewList()
+	for _, name := range names {
+		list.Values = append(list.Values, types.String(name))
+	}
+
+	return list, nil
+}
+
+// MakeFiles creates functions of the package for working with files
+// and directories. Errors of the file system are raised as subclasses
+// of 'ПомилкаВводуВиводу'.
+func MakeFiles(pkg *types.Package) types.StringDict {
+	functions := []*types.Method{
+		newFunction(
+			pkg,
+			"відкрити",
+			[]types.MethodParameter{stringParameter("шлях"), stringParameter("режим")},
+			types.FileClass,
+			func(ctx types.Context, args types.Tuple) (types.Object, error) {
+				return types.FileOpen(goString(args[0]), goString(args[1]))
+			},
+		),
+		newPathFunction(
+			pkg, "прочитати", types.StringClass, func(_ types.Context, path string) (types.Object, error) {
+				text, err := readText(path)
+				if err != nil {
+					return nil, err
+				}
+
+				return types.String(text), nil
+			},
+		),
+		newPathFunction(
+			pkg, "прочитати_байти", types.ListClass, func(_ types.Context, path string) (types.Object, error) {
+				data, err := os.ReadFile(path)
+				if err != nil {
+					return nil, types.IOErrorFromGo(err)
+				}
+
+				return types.BytesToList(data), nil
+			},
+		),
+		newPathFunction(
+			pkg, "рядки", types.ListClass, func(_ types.Context, path string) (types.Object, error) {
+				text, err := readText(path)
+				if err != nil {
+					return nil, err
+				}
+
+				list := types.NewList()
+				if text == "" {
+					return list, nil
+				}
+
+				for _, line := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+					list.Values = append(list.Values, types.String(strings.TrimSuffix(line, "\r")))
+				}
+
+				return list, nil
+			},
+		),
+		newWriteFunction(pkg, "записати", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, false),
+		newWriteFunction(pkg, "записати_байти", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, true),
+		newWriteFunction(pkg, "дописати", os.O_WRONLY|os.O_CREATE|os.O_APPEND, false),
+		newPathFunction(pkg, "список_каталогу", types.ListClass, listDirectory),
+		newPathFunction(pkg, "інформація", types.DictionaryClass, fileInfo),
+		newStatPredicate(
+			pkg, "існує", func(os.FileInfo) bool {
+				return true
+			},
+		),
+		newStatPredicate(
+			pkg, "є_файлом", func(info os.FileInfo) bool {
+				return info.Mode().IsRegular()
+			},
+		),
+		newStatPredicate(
+			pkg, "є_каталогом", func(info os.FileInfo) bool {
+				return info.IsDir()
+			},
+		),
+		newPathAction(
+			pkg, "створити_каталог", func(path string) error {
+				return os.Mkdir(path, 0777)
+			},
+		),
+		newPathAction(
+			pkg, "створити_каталоги", func(path string) error {
+				return os.MkdirAll(path, 0777)
+			},
+		),
+		newPathAction(pkg, "видалити", os.Remove),
+		newPathAction(pkg, "видалити_все", os.RemoveAll),
+		newFunction(
+			pkg,
+			"перейменувати",
+			[]types.MethodParameter{stringParameter("старий_шлях"), stringParameter("новий_шлях")},
+			types.NilClass,
+			func(_ types.Context, args types.Tuple) (types.Object, error) {
+				if err := os.Rename(goString(args[0]), goString(args[1])); err != nil {
+					return nil, types.IOErrorFromGo(err)
+				}
+
+				return types.Nil, nil
+			},
+		),
+		newFunction(
+			pkg, "тимчасовий_файл", nil, types.FileClass,
+			func(_ types.Context, _ types.Tuple) (types.Object, error) {
+				file, err := os.CreateTemp("", "борщ-*")
+				if err != nil {
+					return nil, types.IOErrorFromGo(err)
+				}
+
+				return types.FileFromGo(file, false), nil
+			},
+		),
+		newFunction(
+			pkg, "тимчасовий_каталог", nil, types.StringClass,
+			func(_ types.Context, _ types.Tuple) (types.Object, error) {
+				path, err := os.MkdirTemp("", "борщ-*")
+				if err != nil {
+					return nil, types.IOErrorFromGo(err)
+				}
+
+				return types.String(path), nil
+			},
+		),
+		newFunction(
+			pkg, "поточний_каталог", nil, types.StringClass,
+			func(_ types.Context, _ types.Tuple) (types.Object, error) {
+				path, err := os.Getwd()
+				if err != nil {
+					return nil, types.IOErrorFromGo(err)
+				}
+
+				return types.String(path), nil
+			},
+		),
+	}
+
+	dict := types.StringDict{
+		types.FileClass.Name: types.FileClass,
+	}
+
+	for _, function := range functions {
+		dict[function.Name] = function
+	}
+
+	return dict
+}
+
+func newPathTransformation(pkg *types.Package, name string, f func(path string) string) *types.Method {
+	return newPathFunction(
+		pkg, name, types.StringClass, func(_ types.Context, path string) (types.Object, error) {
+			return types.String(f(path)), nil
+		},
+	)
+}
+
+// MakePath creates functions of the package for manipulating paths,
+// the file system is not accessed except for 'абсолютний()'.
+func MakePath(pkg *types.Package) types.StringDict {
+	functions := []*types.Method{
+		newFunction(
+			pkg,
+			"з_єднати",
+			[]types.MethodParameter{stringParameter("шлях"), stringParameter("елемент")},
+			types.StringClass,
+			func(_ types.Context, args types.Tuple) (types.Object, error) {
+				return types.String(filepath.Join(goString(args[0]), goString(args[1]))), nil
+			},
+		),
+		newFunction(
+			pkg,
+			"розділити",
+			[]types.MethodParameter{stringParameter("шлях")},
+			types.TupleClass,
+			func(_ types.Context, args types.Tuple) (types.Object, error) {
+				dir, name := filepath.Split(goString(args[0]))
+				return &types.Tuple{types.String(dir), types.String(name)}, nil
+			},
+		),
+		newPathTransformation(pkg, "ім_я", filepath.Base),
+		newPathTransformation(pkg, "каталог", filepath.Dir),
+		newPathTransformation(pkg, "розширення", filepath.Ext),
+		newPathTransformation(pkg, "нормалізувати", filepath.Clean),
+		newPathTransformation(
+			pkg, "без_розширення", func(path string) string {
+				return strings.TrimSuffix(path, filepath.Ext(path))
+			},
+		),
+		newPathFunction(
+			pkg, "абсолютний", types.StringClass, func(_ types.Context, path string) (types.Object, error) {
+				absolute, err := filepath.Abs(path)
+				if err != nil {
+					return nil, types.IOErrorFromGo(err)
+				}
+
+				return types.String(absolute), nil
+			},
+		),
+		newPathFunction(
+			pkg, "є_абсолютним", types.BoolClass, func(_ types.Context, path string) (types.Object, error) {
+				return types.NewBool(filepath.IsAbs(path)), nil
+			},
+		),
+	}
+
+	dict := types.StringDict{
+		"роздільник": types.String(string(filepath.Separator)),
+	}
+
+	for _, function := range functions {
+		dict[function.Name] = function
+	}
+
+	return dict
+}
@@ -0,0 +1,46 @@
+package packages
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+type functionFunc func(ctx types.Context, args types.Tuple) (types.Object, error)
+
+// newFunction creates a function of the package, which returns a single
+// value of the class or nil if the class is 'нульове'.
+func newFunction(
+	pkg *types.Package,
+	name string,
+	parameters []types.MethodParameter,
+	returnType *types.Class,
+	f functionFunc,
+) *types.Method {
+	return types.FunctionNew(
+		name, pkg, parameters,
+		[]types.MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: returnType == types.NilClass,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			return f(ctx, args)
+		},
+	)
+}
+
+func objectParameter(name string) types.MethodParameter {
+	return types.MethodParameter{
+		Class:      types.ObjectClass,
+		Name:       name,
+		IsNullable: true,
+		IsVariadic: false,
+	}
+}
+
+func stringParameter(name string) types.MethodParameter {
+	return types.MethodParameter{
+		Class:      types.StringClass,
+		Name:       name,
+		IsNullable: false,
+		IsVariadic: false,
+	}
+}
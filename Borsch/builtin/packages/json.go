@@ -0,0 +1,608 @@
+package packages
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+)
+
+const JSONPackageName = "json"
+
+// JSONHookName is the name of the method, which user classes define to
+// provide the value to encode instead of the instance, i.e. a dictionary
+// of its attributes.
+const JSONHookName = "серіалізувати"
+
+// jsonEncoder writes objects as JSON. If indent is not empty, elements
+// of arrays and objects are written on separate lines.
+type jsonEncoder struct {
+	ctx     types.Context
+	builder strings.Builder
+	indent  string
+
+	// visited contains the containers being encoded to detect cycles.
+	visited map[types.Object]bool
+}
+
+func (e *jsonEncoder) newline(depth int) {
+	if e.indent != "" {
+		e.builder.WriteByte('\n')
+		e.builder.WriteString(strings.Repeat(e.indent, depth))
+	}
+}
+
+func (e *jsonEncoder) writeString(s string) {
+	e.builder.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			e.builder.WriteString(`\"`)
+		case '\\':
+			e.builder.WriteString(`\\`)
+		case '\n':
+			e.builder.WriteString(`\n`)
+		case '\r':
+			e.builder.WriteString(`\r`)
+		case '\t':
+			e.builder.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				e.builder.WriteString(`\u00`)
+				e.builder.WriteString(strconv.FormatInt(int64(r)>>4, 16))
+				e.builder.WriteString(strconv.FormatInt(int64(r)&0xf, 16))
+			} else {
+				e.builder.WriteRune(r)
+			}
+		}
+	}
+
+	e.builder.WriteByte('"')
+}
+
+// writeReal writes the real number so that it is decoded as a real
+// number again, i.e. 1.0 instead of 1.
+func (e *jsonEncoder) writeReal(x float64) error {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return types.NewValueErrorf("значення %v не може бути перетворене у JSON", x)
+	}
+
+	s := strconv.FormatFloat(x, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+
+	e.builder.WriteString(s)
+	return nil
+}
+
+// enter marks the container as being encoded, an error is returned if
+// the container contains itself.
+func (e *jsonEncoder) enter(value types.Object) error {
+	if e.visited[value] {
+		return types.NewValueErrorf("циклічне посилання не може бути перетворене у JSON")
+	}
+
+	e.visited[value] = true
+	return nil
+}
+
+func (e *jsonEncoder) writeArray(value types.Object, elements []types.Object, depth int) error {
+	if err := e.enter(value); err != nil {
+		return err
+	}
+
+	e.builder.WriteByte('[')
+	for i, element := range elements {
+		if i != 0 {
+			e.builder.WriteByte(',')
+		}
+
+		e.newline(depth + 1)
+		if err := e.encode(element, depth+1); err != nil {
+			return err
+		}
+	}
+
+	if len(elements) != 0 {
+		e.newline(depth)
+	}
+
+	e.builder.WriteByte(']')
+	delete(e.visited, value)
+	return nil
+}
+
+func (e *jsonEncoder) writeObject(value *types.Dictionary, depth int) error {
+	if err := e.enter(value); err != nil {
+		return err
+	}
+
+	separator := ":"
+	if e.indent != "" {
+		separator = ": "
+	}
+
+	e.builder.WriteByte('{')
+	keys := value.Keys()
+	for i, key := range keys {
+		name, ok := key.(types.String)
+		if !ok {
+			return types.NewTypeErrorf("ключі об'єкта JSON мають бути рядками, отримано '%s'", key.Class().Name)
+		}
+
+		if i != 0 {
+			e.builder.WriteByte(',')
+		}
+
+		e.newline(depth + 1)
+		e.writeString(string(name))
+		e.builder.WriteString(separator)
+		item, err := value.GetItem(e.ctx, key)
+		if err != nil {
+			return err
+		}
+
+		if err := e.encode(item, depth+1); err != nil {
+			return err
+		}
+	}
+
+	if len(keys) != 0 {
+		e.newline(depth)
+	}
+
+	e.builder.WriteByte('}')
+	delete(e.visited, value)
+	return nil
+}
+
+// writeHook encodes the value returned by the method of the user class
+// instead of the instance.
+func (e *jsonEncoder) writeHook(value types.Object, depth int) error {
+	hook, err := types.GetAttribute(e.ctx, value, JSONHookName)
+	if err != nil {
+		return types.NewTypeErrorf(
+			"об'єкт типу '%s' не може бути перетворений у JSON, клас має визначати метод '%s'",
+			value.Class().Name,
+			JSONHookName,
+		)
+	}
+
+	if err := e.enter(value); err != nil {
+		return err
+	}
+
+	result, err := types.Call(e.ctx, hook, types.Tuple{})
+	if err != nil {
+		return err
+	}
+
+	if err := e.encode(result, depth); err != nil {
+		return err
+	}
+
+	delete(e.visited, value)
+	return nil
+}
+
+func (e *jsonEncoder) encode(value types.Object, depth int) error {
+	switch v := value.(type) {
+	case types.NilType:
+		e.builder.WriteString("null")
+	case types.Bool:
+		e.builder.WriteString(strconv.FormatBool(bool(v)))
+	case types.Int:
+		e.builder.WriteString(strconv.FormatInt(int64(v), 10))
+	case *types.BigInt:
+		e.builder.WriteString(v.Big().String())
+	case types.Real:
+		return e.writeReal(float64(v))
+	case types.String:
+		e.writeString(string(v))
+	case *types.List:
+		return e.writeArray(v, v.Values, depth)
+	case *types.Tuple:
+		return e.writeArray(v, *v, depth)
+	case *types.Dictionary:
+		return e.writeObject(v, depth)
+	default:
+		return e.writeHook(value, depth)
+	}
+
+	return nil
+}
+
+func encodeJSON(ctx types.Context, value types.Object, indent string) (types.Object, error) {
+	e := &jsonEncoder{ctx: ctx, indent: indent, visited: map[types.Object]bool{}}
+	if err := e.encode(value, 0); err != nil {
+		return nil, err
+	}
+
+	return types.String(e.builder.String()), nil
+}
+
+// jsonDecoder reads JSON from the text, errors contain the line and the
+// column of the wrong character.
+type jsonDecoder struct {
+	ctx  types.Context
+	text string
+	pos  int
+}
+
+func (d *jsonDecoder) errorf(format string, args ...interface{}) error {
+	line := strings.Count(d.text[:d.pos], "\n") + 1
+	column := utf8.RuneCountInString(d.text[strings.LastIndexByte(d.text[:d.pos], '\n')+1:d.pos]) + 1
+	return types.NewValueErrorf(
+		"некоректний JSON (рядок %d, позиція %d): %s", line, column, fmt.Sprintf(format, args...),
+	)
+}
+
+func (d *jsonDecoder) unexpected() error {
+	if d.pos >= len(d.text) {
+		return d.errorf("неочікуваний кінець даних")
+	}
+
+	r, _ := utf8.DecodeRuneInString(d.text[d.pos:])
+	return d.errorf("неочікуваний символ '%c'", r)
+}
+
+func (d *jsonDecoder) skipSpaces() {
+	for d.pos < len(d.text) {
+		switch d.text[d.pos] {
+		case ' ', '\t', '\n', '\r':
+			d.pos++
+		default:
+			return
+		}
+	}
+}
+
+// expect skips spaces and the byte, it returns false if there is
+// another byte.
+func (d *jsonDecoder) expect(b byte) bool {
+	d.skipSpaces()
+	if d.pos < len(d.text) && d.text[d.pos] == b {
+		d.pos++
+		return true
+	}
+
+	return false
+}
+
+func (d *jsonDecoder) decode() (types.Object, error) {
+	d.skipSpaces()
+	if d.pos >= len(d.text) {
+		return nil, d.unexpected()
+	}
+
+	switch c := d.text[d.pos]; {
+	case c == '{':
+		return d.decodeObject()
+	case c == '[':
+		return d.decodeArray()
+	case c == '"':
+		s, err := d.decodeString()
+		if err != nil {
+			return nil, err
+		}
+
+		return types.String(s), nil
+	case c == '-' || c >= '0' && c <= '9':
+		return d.decodeNumber()
+	default:
+		for literal, value := range jsonLiterals {
+			if strings.HasPrefix(d.text[d.pos:], literal) {
+				d.pos += len(literal)
+				return value, nil
+			}
+		}
+
+		return nil, d.unexpected()
+	}
+}
+
+func (d *jsonDecoder) decodeArray() (types.Object, error) {
+	d.pos++
+	list := types.NewList()
+	if d.expect(']') {
+		return list, nil
+	}
+
+	for {
+		element, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+
+		list.Values = append(list.Values, element)
+		if d.expect(']') {
+			return list, nil
+		}
+
+		if !d.expect(',') {
+			return nil, d.unexpected()
+		}
+	}
+}
+
+func (d *jsonDecoder) decodeObject() (types.Object, error) {
+	d.pos++
+	dict := types.NewDictionary()
+	if d.expect('}') {
+		return dict, nil
+	}
+
+	for {
+		d.skipSpaces()
+		if d.pos >= len(d.text) || d.text[d.pos] != '"' {
+			return nil, d.unexpected()
+		}
+
+		key, err := d.decodeString()
+		if err != nil {
+			return nil, err
+		}
+
+		if !d.expect(':') {
+			return nil, d.unexpected()
+		}
+
+		item, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := dict.SetItem(d.ctx, types.String(key), item); err != nil {
+			return nil, err
+		}
+
+		if d.expect('}') {
+			return dict, nil
+		}
+
+		if !d.expect(',') {
+			return nil, d.unexpected()
+		}
+	}
+}
+
+// hex4 reads four hexadecimal digits of the escape sequence "\uXXXX".
+func (d *jsonDecoder) hex4() (rune, error) {
+	if d.pos+4 > len(d.text) {
+		d.pos = len(d.text)
+		return 0, d.unexpected()
+	}
+
+	code, err := strconv.ParseUint(d.text[d.pos:d.pos+4], 16, 16)
+	if err != nil {
+		return 0, d.errorf("некоректна послідовність '\\u%s'", d.text[d.pos:d.pos+4])
+	}
+
+	d.pos += 4
+	return rune(code), nil
+}
+
+// jsonEscapes maps characters after '\\' in strings to their values.
+var jsonEscapes = map[byte]string{
+	'"': "\"", '\\': "\\", '/': "/", 'b': "\b", 'f': "\f", 'n': "\n", 'r': "\r", 't': "\t",
+}
+
+// jsonLiterals are the values of the keywords of JSON.
+var jsonLiterals = map[string]types.Object{"null": types.Nil, "true": types.True, "false": types.False}
+
+func (d *jsonDecoder) decodeString() (string, error) {
+	d.pos++
+	var builder strings.Builder
+	for {
+		if d.pos >= len(d.text) {
+			return "", d.unexpected()
+		}
+
+		r, size := utf8.DecodeRuneInString(d.text[d.pos:])
+		switch {
+		case r == '"':
+			d.pos++
+			return builder.String(), nil
+		case r == utf8.RuneError && size == 1:
+			return "", d.errorf("некоректний символ UTF-8")
+		case r < 0x20:
+			return "", d.errorf("керуючий символ у рядку")
+		case r != '\\':
+			builder.WriteRune(r)
+			d.pos += size
+			continue
+		}
+
+		d.pos++
+		if d.pos >= len(d.text) {
+			return "", d.unexpected()
+		}
+
+		if s, ok := jsonEscapes[d.text[d.pos]]; ok {
+			builder.WriteString(s)
+			d.pos++
+			continue
+		}
+
+		if d.text[d.pos] != 'u' {
+			return "", d.errorf("некоректна послідовність екранування '\\%c'", d.text[d.pos])
+		}
+
+		d.pos++
+		r, err := d.hex4()
+		if err != nil {
+			return "", err
+		}
+
+		// Characters outside the basic plane are written as surrogate
+		// pairs, a single surrogate is replaced with U+FFFD.
+		if utf16IsHighSurrogate(r) && strings.HasPrefix(d.text[d.pos:], `\u`) {
+			pos := d.pos
+			d.pos += 2
+			low, err := d.hex4()
+			if err != nil {
+				return "", err
+			}
+
+			if combined := utf16Decode(r, low); combined != utf8.RuneError {
+				r = combined
+			} else {
+				d.pos = pos
+			}
+		}
+
+		builder.WriteRune(r)
+	}
+}
+
+func utf16IsHighSurrogate(r rune) bool {
+	return r >= 0xd800 && r < 0xdc00
+}
+
+func utf16Decode(high, low rune) rune {
+	if low < 0xdc00 || low >= 0xe000 {
+		return utf8.RuneError
+	}
+
+	return (high-0xd800)<<10 | (low - 0xdc00) + 0x10000
+}
+
+func (d *jsonDecoder) digits() int {
+	start := d.pos
+	for d.pos < len(d.text) && d.text[d.pos] >= '0' && d.text[d.pos] <= '9' {
+		d.pos++
+	}
+
+	return d.pos - start
+}
+
+// decodeNumber reads an integer, which can be arbitrarily large, or
+// a real number if it has a fraction or an exponent.
+func (d *jsonDecoder) decodeNumber() (types.Object, error) {
+	start := d.pos
+	if d.text[d.pos] == '-' {
+		d.pos++
+	}
+
+	if d.pos < len(d.text) && d.text[d.pos] == '0' {
+		d.pos++
+	} else if d.digits() == 0 {
+		return nil, d.unexpected()
+	}
+
+	isReal := false
+	if d.pos < len(d.text) && d.text[d.pos] == '.' {
+		d.pos++
+		isReal = true
+		if d.digits() == 0 {
+			return nil, d.unexpected()
+		}
+	}
+
+	if d.pos < len(d.text) && (d.text[d.pos] == 'e' || d.text[d.pos] == 'E') {
+		d.pos++
+		isReal = true
+		if d.pos < len(d.text) && (d.text[d.pos] == '+' || d.text[d.pos] == '-') {
+			d.pos++
+		}
+
+		if d.digits() == 0 {
+			return nil, d.unexpected()
+		}
+	}
+
+	number := d.text[start:d.pos]
+	if !isReal {
+		return types.IntFromString(number, 10)
+	}
+
+	x, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		d.pos = start
+		return nil, d.errorf("число '%s' поза допустимим діапазоном", number)
+	}
+
+	return types.Real(x), nil
+}
+
+func decodeJSON(ctx types.Context, text string) (types.Object, error) {
+	d := &jsonDecoder{ctx: ctx, text: text}
+	value, err := d.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	d.skipSpaces()
+	if d.pos < len(d.text) {
+		return nil, d.errorf("зайві дані після значення")
+	}
+
+	return value, nil
+}
+
+// MakeJSON creates functions of the package for converting objects to
+// JSON and back. Arrays are decoded as lists, objects as dictionaries
+// with the order of keys preserved.
+func MakeJSON(pkg *types.Package) types.StringDict {
+	functions := []*types.Method{
+		newFunction(
+			pkg, "закодувати", []types.MethodParameter{objectParameter("значення")}, types.StringClass,
+			func(ctx types.Context, args types.Tuple) (types.Object, error) {
+				return encodeJSON(ctx, args[0], "")
+			},
+		),
+		newFunction(
+			pkg,
+			"закодувати_з_відступом",
+			[]types.MethodParameter{
+				objectParameter("значення"),
+				{
+					Class:      types.IntClass,
+					Classes:    []*types.Class{types.StringClass},
+					Name:       "відступ",
+					IsNullable: false,
+					IsVariadic: false,
+				},
+			},
+			types.StringClass,
+			func(ctx types.Context, args types.Tuple) (types.Object, error) {
+				indent, ok := args[1].(types.String)
+				if !ok {
+					n, err := types.ToGoInt(ctx, args[1])
+					if err != nil {
+						return nil, err
+					}
+
+					if n < 0 {
+						return nil, types.NewValueErrorf("відступ не може бути від'ємним")
+					}
+
+					indent = types.String(strings.Repeat(" ", n))
+				}
+
+				if indent == "" {
+					return nil, types.NewValueErrorf("відступ не може бути порожнім")
+				}
+
+				return encodeJSON(ctx, args[0], string(indent))
+			},
+		),
+		newFunction(
+			pkg, "розкодувати", []types.MethodParameter{stringParameter("текст")}, types.ObjectClass,
+			func(ctx types.Context, args types.Tuple) (types.Object, error) {
+				return decodeJSON(ctx, goString(args[0]))
+			},
+		),
+	}
+
+	dict := types.StringDict{}
+	for _, function := range functions {
+		dict[function.Name] = function
+	}
+
+	return dict
+}
@@ -0,0 +1,371 @@
+package packages
+
+import (
+	"math"
+	"math/big"
+	"math/cmplx"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+)
+
+const MathPackageName = "математика"
+
+type realFunc func(x float64) float64
+
+func realArgument(ctx types.Context, arg types.Object) (float64, error) {
+	x, err := types.ToReal(ctx, arg)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(x.(types.Real)), nil
+}
+
+// integerArgument converts an integer, i.e. Int, BigInt or Bool,
+// to *big.Int, which must not be modified.
+func integerArgument(arg types.Object) *big.Int {
+	switch value := arg.(type) {
+	case *types.BigInt:
+		return value.Big()
+	case types.Bool:
+		if value {
+			return big.NewInt(1)
+		}
+
+		return big.NewInt(0)
+	default:
+		return big.NewInt(int64(value.(types.Int)))
+	}
+}
+
+func integerParameter(name string) types.MethodParameter {
+	return types.MethodParameter{
+		Class:      types.IntClass,
+		Classes:    []*types.Class{types.BoolClass},
+		Name:       name,
+		IsNullable: false,
+		IsVariadic: false,
+	}
+}
+
+func domainError(name string) error {
+	return types.NewValueErrorf("аргумент функції '%s' поза областю визначення", name)
+}
+
+func newMathFunction(
+	pkg *types.Package,
+	name string,
+	parameters []types.MethodParameter,
+	returnType *types.Class,
+	f func(ctx types.Context, args types.Tuple) (types.Object, error),
+) *types.Method {
+	return types.FunctionNew(
+		name, pkg, parameters,
+		[]types.MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: false,
+			},
+		},
+		func(ctx types.Context, args types.Tuple, kwargs types.StringDict) (types.Object, error) {
+			return f(ctx, args)
+		},
+	)
+}
+
+// newRealFunction creates a function of one real argument, which
+// raises ValueError if outOfDomain returns true for the argument.
+func newRealFunction(pkg *types.Package, name string, f realFunc, outOfDomain func(x float64) bool) *types.Method {
+	return newMathFunction(
+		pkg, name, []types.MethodParameter{numberParameter("х")}, types.RealClass,
+		func(ctx types.Context, args types.Tuple) (types.Object, error) {
+			x, err := realArgument(ctx, args[0])
+			if err != nil {
+				return nil, err
+			}
+
+			if outOfDomain != nil && outOfDomain(x) {
+				return nil, domainError(name)
+			}
+
+			return types.Real(f(x)), nil
+		},
+	)
+}
+
+// newRealFunction2 creates a function of two real arguments, which
+// raises ValueError if outOfDomain returns true for the arguments.
+func newRealFunction2(
+	pkg *types.Package,
+	name string,
+	parameters [2]string,
+	f func(x, y float64) float64,
+	outOfDomain func(x, y float64) bool,
+) *types.Method {
+	return newMathFunction(
+		pkg, name, []types.MethodParameter{numberParameter(parameters[0]), numberParameter(parameters[1])},
+		types.RealClass,
+		func(ctx types.Context, args types.Tuple) (types.Object, error) {
+			x, err := realArgument(ctx, args[0])
+			if err != nil {
+				return nil, err
+			}
+
+			y, err := realArgument(ctx, args[1])
+			if err != nil {
+				return nil, err
+			}
+
+			if outOfDomain != nil && outOfDomain(x, y) {
+				return nil, domainError(name)
+			}
+
+			return types.Real(f(x, y)), nil
+		},
+	)
+}
+
+// newRoundingFunction creates a function, which rounds the number to
+// an integer. Integers are returned as they are.
+func newRoundingFunction(pkg *types.Package, name, parameter string, f realFunc) *types.Method {
+	return newMathFunction(
+		pkg, name, []types.MethodParameter{numberParameter(parameter)}, types.IntClass,
+		func(ctx types.Context, args types.Tuple) (types.Object, error) {
+			switch args[0].(type) {
+			case types.Int, *types.BigInt, types.Bool:
+				return types.IntFromBig(new(big.Int).Set(integerArgument(args[0]))), nil
+			}
+
+			x, err := realArgument(ctx, args[0])
+			if err != nil {
+				return nil, err
+			}
+
+			return types.ToInt(ctx, types.Real(f(x)))
+		},
+	)
+}
+
+func newPredicate(pkg *types.Package, name string, f func(x float64) bool) *types.Method {
+	return newMathFunction(
+		pkg, name, []types.MethodParameter{numberParameter("х")}, types.BoolClass,
+		func(ctx types.Context, args types.Tuple) (types.Object, error) {
+			switch args[0].(type) {
+			case types.Int, *types.BigInt, types.Bool:
+				return types.NewBool(f(0)), nil
+			}
+
+			x, err := realArgument(ctx, args[0])
+			if err != nil {
+				return nil, err
+			}
+
+			return types.NewBool(f(x)), nil
+		},
+	)
+}
+
+func isNegative(x float64) bool {
+	return x < 0
+}
+
+func isInfinite(x float64) bool {
+	return math.IsInf(x, 0)
+}
+
+func isNotPositive(x float64) bool {
+	return x <= 0
+}
+
+func isOutsideUnitInterval(x float64) bool {
+	return x < -1 || x > 1
+}
+
+func factorial(ctx types.Context, args types.Tuple) (types.Object, error) {
+	n := integerArgument(args[0])
+	if n.Sign() < 0 {
+		return nil, types.NewValueErrorf("факторіал визначений лише для невід'ємних цілих чисел")
+	}
+
+	if !n.IsInt64() {
+		return nil, types.NewOverflowErrorf("аргумент факторіала занадто великий")
+	}
+
+	return types.IntFromBig(new(big.Int).MulRange(1, n.Int64())), nil
+}
+
+func gcd(ctx types.Context, args types.Tuple) (types.Object, error) {
+	a := new(big.Int).Abs(integerArgument(args[0]))
+	b := new(big.Int).Abs(integerArgument(args[1]))
+	return types.IntFromBig(new(big.Int).GCD(nil, nil, a, b)), nil
+}
+
+func lcm(ctx types.Context, args types.Tuple) (types.Object, error) {
+	a := new(big.Int).Abs(integerArgument(args[0]))
+	b := new(big.Int).Abs(integerArgument(args[1]))
+	if a.Sign() == 0 || b.Sign() == 0 {
+		return types.Int(0), nil
+	}
+
+	divisor := new(big.Int).GCD(nil, nil, a, b)
+	return types.IntFromBig(new(big.Int).Mul(new(big.Int).Quo(a, divisor), b)), nil
+}
+
+func abs(ctx types.Context, args types.Tuple) (types.Object, error) {
+	if z, ok := args[0].(types.Complex); ok {
+		return types.Real(cmplx.Abs(complex128(z))), nil
+	}
+
+	if _, err := types.ToComplex(ctx, args[0]); err != nil {
+		return nil, err
+	}
+
+	negative, err := types.Less(ctx, args[0], types.Int(0))
+	if err != nil {
+		return nil, err
+	}
+
+	if negative.(types.Bool) {
+		return types.Negate(ctx, args[0])
+	}
+
+	return args[0], nil
+}
+
+// round rounds the number to the given number of digits after the
+// decimal point, halves are rounded away from zero.
+func round(ctx types.Context, args types.Tuple) (types.Object, error) {
+	x, err := realArgument(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	digits, err := types.ToGoInt(ctx, args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	scale := math.Pow(10, float64(digits))
+	if math.IsInf(scale, 0) || math.IsInf(x*scale, 0) || scale == 0 {
+		return types.Real(x), nil
+	}
+
+	return types.Real(math.Round(x*scale) / scale), nil
+}
+
+// MakeMath creates functions and constants of the mathematics
+// package. The package also provides the functions and constants
+// of the former "математика/функції" and "математика/константи".
+func MakeMath(pkg *types.Package) types.StringDict {
+	dict := types.StringDict{
+		"е":   types.Real(math.E),
+		"пі":  types.Real(math.Pi),
+		"тау": types.Real(2 * math.Pi),
+		"фі":  types.Real(math.Phi),
+
+		"корінь_2":  types.Real(math.Sqrt2),
+		"корінь_е":  types.Real(math.SqrtE),
+		"корінь_пі": types.Real(math.SqrtPi),
+		"корінь_фі": types.Real(math.SqrtPhi),
+
+		"лог_е2":  types.Real(math.Ln2),
+		"лог_2е":  types.Real(math.Log2E),
+		"лог_10":  types.Real(math.Ln10),
+		"лог_10е": types.Real(math.Log10E),
+
+		"макс_дійсне":          types.Real(math.MaxFloat64),
+		"мін_ненульове_дійсне": types.Real(math.SmallestNonzeroFloat64),
+		"нескінченність":       types.Real(math.Inf(1)),
+		"нечисло":              types.Real(math.NaN()),
+
+		"макс_ціле": types.Int(math.MaxInt64),
+		"мін_ціле":  types.Int(math.MinInt64),
+	}
+
+	functions := []*types.Method{
+		newRealFunction(pkg, "корінь", math.Sqrt, isNegative),
+		newRealFunction(pkg, "кубічний_корінь", math.Cbrt, nil),
+		newRealFunction(pkg, "експонента", math.Exp, nil),
+		newRealFunction(pkg, "логарифм", math.Log, isNotPositive),
+		newRealFunction(pkg, "логарифм2", math.Log2, isNotPositive),
+		newRealFunction(pkg, "логарифм10", math.Log10, isNotPositive),
+		newRealFunction2(
+			pkg, "логарифм_за_основою", [2]string{"х", "основа"}, func(x, base float64) float64 {
+				return math.Log(x) / math.Log(base)
+			}, func(x, base float64) bool {
+				return x <= 0 || base <= 0 || base == 1
+			},
+		),
+		newRealFunction2(
+			pkg, "степінь", [2]string{"х", "у"}, math.Pow, func(x, y float64) bool {
+				return x < 0 && y != math.Trunc(y) && !math.IsInf(y, 0) || x == 0 && y < 0
+			},
+		),
+		newRealFunction(pkg, "синус", math.Sin, isInfinite),
+		newRealFunction(pkg, "косинус", math.Cos, isInfinite),
+		newRealFunction(pkg, "тангенс", math.Tan, isInfinite),
+		newRealFunction(pkg, "арксинус", math.Asin, isOutsideUnitInterval),
+		newRealFunction(pkg, "арккосинус", math.Acos, isOutsideUnitInterval),
+		newRealFunction(pkg, "арктангенс", math.Atan, nil),
+		newRealFunction2(pkg, "арктангенс2", [2]string{"у", "х"}, math.Atan2, nil),
+		newRealFunction(pkg, "гіперболічний_синус", math.Sinh, nil),
+		newRealFunction(pkg, "гіперболічний_косинус", math.Cosh, nil),
+		newRealFunction(pkg, "гіперболічний_тангенс", math.Tanh, nil),
+		newRealFunction(pkg, "гіперболічний_арксинус", math.Asinh, nil),
+		newRealFunction(
+			pkg, "гіперболічний_арккосинус", math.Acosh, func(x float64) bool {
+				return x < 1
+			},
+		),
+		newRealFunction(
+			pkg, "гіперболічний_арктангенс", math.Atanh, func(x float64) bool {
+				return x <= -1 || x >= 1
+			},
+		),
+		newRealFunction(
+			pkg, "градуси", func(x float64) float64 {
+				return x * 180 / math.Pi
+			}, nil,
+		),
+		newRealFunction(
+			pkg, "радіани", func(x float64) float64 {
+				return x * math.Pi / 180
+			}, nil,
+		),
+		newRealFunction2(pkg, "гіпотенуза", [2]string{"х", "у"}, math.Hypot, nil),
+		newRoundingFunction(pkg, "стеля", "х", math.Ceil),
+		newRoundingFunction(pkg, "підлога", "х", math.Floor),
+		newRoundingFunction(pkg, "обрізати", "х", math.Trunc),
+		newPredicate(pkg, "є_нескінченним", isInfinite),
+		newPredicate(pkg, "є_нечислом", math.IsNaN),
+		newPredicate(pkg, "є_скінченним", func(x float64) bool {
+			return !math.IsInf(x, 0) && !math.IsNaN(x)
+		}),
+		newMathFunction(pkg, "модуль", []types.MethodParameter{numberParameter("х")}, types.ObjectClass, abs),
+		newMathFunction(pkg, "факторіал", []types.MethodParameter{integerParameter("число")}, types.IntClass, factorial),
+		newMathFunction(pkg, "нсд", []types.MethodParameter{integerParameter("а"), integerParameter("б")}, types.IntClass, gcd),
+		newMathFunction(pkg, "нск", []types.MethodParameter{integerParameter("а"), integerParameter("б")}, types.IntClass, lcm),
+		newMathFunction(
+			pkg,
+			"степінь_цілого",
+			[]types.MethodParameter{integerParameter("число"), integerParameter("експонента")},
+			types.ObjectClass,
+			func(ctx types.Context, args types.Tuple) (types.Object, error) {
+				return types.Pow(ctx, args[0], args[1])
+			},
+		),
+		newMathFunction(
+			pkg,
+			"заокруглити",
+			[]types.MethodParameter{numberParameter("х"), integerParameter("н_знаків")},
+			types.RealClass,
+			round,
+		),
+	}
+
+	for _, function := range functions {
+		dict[function.Name] = function
+	}
+
+	return dict
+}
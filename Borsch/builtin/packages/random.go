@@ -0,0 +1,75 @@
+package packages
+
+import "github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+
+const (
+	RandomPackageName = "випадкові_числа"
+
+	// MT19937PackageName is the former package of the library, which
+	// gives the same numbers as the original implementation of MT19937.
+	MT19937PackageName = "випадкові_числа/mt19937"
+)
+
+// bindGeneratorMethods returns methods of the generator with the names
+// bound to it, so they can be called as functions of the package.
+func bindGeneratorMethods(generator *types.Generator, names ...string) types.StringDict {
+	dict := types.StringDict{}
+	for _, name := range names {
+		dict[name] = &types.MethodWrapper{
+			Method:   types.GeneratorClass.Dict[name].(*types.Method),
+			Instance: generator,
+		}
+	}
+
+	return dict
+}
+
+// MakeRandom creates functions of the package for pseudo-random
+// numbers. Functions use the MT19937 generator of the package seeded
+// with a random number, 'насіння' makes its numbers reproducible.
+// Separate generators are created by 'генератор(насіння)', and
+// 'безпечний_генератор()' gives numbers of the cryptographically secure
+// source of the operating system.
+func MakeRandom(pkg *types.Package) types.StringDict {
+	dict := bindGeneratorMethods(
+		types.NewRandomGenerator(),
+		"насіння",
+		"випадкове",
+		"ціле_між",
+		"дійсне_між",
+		"вибрати",
+		"перемішати",
+		"вибірка",
+		"нормальний",
+		"логнормальний",
+		"експоненційний",
+		"гамма",
+		"бета",
+		"трикутний",
+	)
+	dict[types.GeneratorClass.Name] = types.GeneratorClass
+
+	secureGenerator := newFunction(
+		pkg, "безпечний_генератор", nil, types.GeneratorClass,
+		func(_ types.Context, _ types.Tuple) (types.Object, error) {
+			return types.NewSecureGenerator(), nil
+		},
+	)
+	dict[secureGenerator.Name] = secureGenerator
+	return dict
+}
+
+// MakeMT19937 creates functions of the original implementation of
+// MT19937. Until seeded, the generator uses the default seed 5489.
+func MakeMT19937(*types.Package) types.StringDict {
+	return bindGeneratorMethods(
+		types.NewUnseededGenerator(),
+		"насіння",
+		"ціле32",
+		"ціле31",
+		"дійсне1",
+		"дійсне2",
+		"дійсне3",
+		"дійсне_розширене53",
+	)
+}
@@ -0,0 +1,108 @@
+package packages
+
+import (
+	"regexp"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+)
+
+const RegexpPackageName = "регулярні_вирази"
+
+func regexpParameter(name string) types.MethodParameter {
+	return types.MethodParameter{
+		Class:      types.RegexpClass,
+		Classes:    []*types.Class{types.StringClass},
+		Name:       name,
+		IsNullable: false,
+		IsVariadic: false,
+	}
+}
+
+// newRegexpFunction creates a function of the package, which takes the
+// regular expression or the string compiled to the expression followed
+// by the text.
+func newRegexpFunction(
+	pkg *types.Package,
+	name string,
+	parameters []types.MethodParameter,
+	returnType *types.Class,
+	f func(ctx types.Context, re *types.Regexp, text string, args types.Tuple) (types.Object, error),
+) *types.Method {
+	return newFunction(
+		pkg,
+		name,
+		append([]types.MethodParameter{regexpParameter("шаблон"), stringParameter("рядок")}, parameters...),
+		returnType,
+		func(ctx types.Context, args types.Tuple) (types.Object, error) {
+			re, err := types.ToRegexp(args[0])
+			if err != nil {
+				return nil, err
+			}
+
+			return f(ctx, re, goString(args[1]), args[2:])
+		},
+	)
+}
+
+// MakeRegexp creates functions of the package for regular expressions
+// with the syntax of RE2. Functions are the same as methods of
+// 'регулярний_вираз', and take the expression or the string as the
+// first argument. 'зіставити' and 'знайти' return нуль if there is no
+// match.
+func MakeRegexp(pkg *types.Package) types.StringDict {
+	dict := types.StringDict{
+		types.RegexpClass.Name: types.RegexpClass,
+		types.MatchClass.Name:  types.MatchClass,
+	}
+
+	functions := []*types.Method{
+		newFunction(
+			pkg, "скомпілювати", []types.MethodParameter{stringParameter("шаблон")}, types.RegexpClass,
+			func(_ types.Context, args types.Tuple) (types.Object, error) {
+				return types.CompileRegexp(goString(args[0]))
+			},
+		),
+		newFunction(
+			pkg, "екранувати", []types.MethodParameter{stringParameter("рядок")}, types.StringClass,
+			func(_ types.Context, args types.Tuple) (types.Object, error) {
+				return types.String(regexp.QuoteMeta(goString(args[0]))), nil
+			},
+		),
+		newRegexpFunction(
+			pkg, "зіставити", nil, types.ObjectClass,
+			func(_ types.Context, re *types.Regexp, text string, _ types.Tuple) (types.Object, error) {
+				return re.Match(text), nil
+			},
+		),
+		newRegexpFunction(
+			pkg, "знайти", nil, types.ObjectClass,
+			func(_ types.Context, re *types.Regexp, text string, _ types.Tuple) (types.Object, error) {
+				return re.Search(text), nil
+			},
+		),
+		newRegexpFunction(
+			pkg, "знайти_всі", nil, types.ListClass,
+			func(_ types.Context, re *types.Regexp, text string, _ types.Tuple) (types.Object, error) {
+				return re.FindAll(text), nil
+			},
+		),
+		newRegexpFunction(
+			pkg, "розділити", nil, types.ListClass,
+			func(_ types.Context, re *types.Regexp, text string, _ types.Tuple) (types.Object, error) {
+				return re.Split(text), nil
+			},
+		),
+		newRegexpFunction(
+			pkg, "замінити", []types.MethodParameter{objectParameter("заміна")}, types.StringClass,
+			func(ctx types.Context, re *types.Regexp, text string, args types.Tuple) (types.Object, error) {
+				return re.Replace(ctx, text, args[0])
+			},
+		),
+	}
+
+	for _, function := range functions {
+		dict[function.Name] = function
+	}
+
+	return dict
+}
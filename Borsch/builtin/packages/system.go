@@ -0,0 +1,215 @@
+package packages
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+)
+
+const SystemPackageName = "система"
+
+// arguments are the command-line arguments of the program after the
+// path to the script.
+var arguments []string
+
+// SetArguments sets the command-line arguments available to programs
+// as 'система.аргументи'.
+func SetArguments(args []string) {
+	arguments = append([]string{}, args...)
+}
+
+func stringList(values []string) *types.List {
+	list := types.NewList()
+	for _, value := range values {
+		list.Values = append(list.Values, types.String(value))
+	}
+
+	return list
+}
+
+func environment(ctx types.Context, _ types.Tuple) (types.Object, error) {
+	dict := types.NewDictionary()
+	for _, variable := range os.Environ() {
+		parts := strings.SplitN(variable, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		if _, err := dict.SetItem(ctx, types.String(parts[0]), types.String(parts[1])); err != nil {
+			return nil, err
+		}
+	}
+
+	return dict, nil
+}
+
+// execute runs the program with the arguments and returns its exit code
+// with the captured standard output and standard error.
+func execute(ctx types.Context, args types.Tuple) (types.Object, error) {
+	var programArgs []string
+	err := types.IterateOver(
+		ctx, args[1], func(element types.Object) (bool, error) {
+			arg, ok := element.(types.String)
+			if !ok {
+				return false, types.NewTypeErrorf("аргумент програми має бути рядком, отримано '%s'", element.Class().Name)
+			}
+
+			programArgs = append(programArgs, string(arg))
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	program := goString(args[0])
+	cmd := exec.Command(program, programArgs...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	code := 0
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		switch {
+		case errors.As(err, &exitErr):
+			code = exitErr.ExitCode()
+		case errors.Is(err, exec.ErrNotFound):
+			return nil, types.NewIOErrorf("програму '%s' не знайдено", program)
+		default:
+			return nil, types.IOErrorFromGo(err)
+		}
+	}
+
+	return &types.Tuple{types.Int(code), types.String(stdout.String()), types.String(stderr.String())}, nil
+}
+
+// MakeSystem creates functions of the package for interaction with the
+// operating system: command-line arguments, environment variables,
+// the exit code and execution of other programs.
+func MakeSystem(pkg *types.Package) types.StringDict {
+	functions := []*types.Method{
+		newFunction(
+			pkg, "вийти", []types.MethodParameter{integerParameter("код")}, types.NilClass,
+			func(ctx types.Context, args types.Tuple) (types.Object, error) {
+				code, err := types.ToGoInt(ctx, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				return nil, &types.ExitError{Code: code}
+			},
+		),
+		types.FunctionNew(
+			"змінна_середовища", pkg, []types.MethodParameter{stringParameter("ім_я")},
+			[]types.MethodReturnType{
+				{
+					Class:      types.StringClass,
+					IsNullable: true,
+				},
+			},
+			func(_ types.Context, args types.Tuple, _ types.StringDict) (types.Object, error) {
+				if value, ok := os.LookupEnv(goString(args[0])); ok {
+					return types.String(value), nil
+				}
+
+				return types.Nil, nil
+			},
+		),
+		newFunction(
+			pkg,
+			"встановити_змінну_середовища",
+			[]types.MethodParameter{stringParameter("ім_я"), stringParameter("значення")},
+			types.NilClass,
+			func(_ types.Context, args types.Tuple) (types.Object, error) {
+				if err := os.Setenv(goString(args[0]), goString(args[1])); err != nil {
+					return nil, types.NewValueErrorf("некоректна змінна середовища '%s'", goString(args[0]))
+				}
+
+				return types.Nil, nil
+			},
+		),
+		newFunction(
+			pkg,
+			"видалити_змінну_середовища",
+			[]types.MethodParameter{stringParameter("ім_я")},
+			types.NilClass,
+			func(_ types.Context, args types.Tuple) (types.Object, error) {
+				if err := os.Unsetenv(goString(args[0])); err != nil {
+					return nil, types.NewValueErrorf("некоректна змінна середовища '%s'", goString(args[0]))
+				}
+
+				return types.Nil, nil
+			},
+		),
+		newFunction(pkg, "змінні_середовища", nil, types.DictionaryClass, environment),
+		newFunction(
+			pkg, "поточний_каталог", nil, types.StringClass,
+			func(_ types.Context, _ types.Tuple) (types.Object, error) {
+				path, err := os.Getwd()
+				if err != nil {
+					return nil, types.IOErrorFromGo(err)
+				}
+
+				return types.String(path), nil
+			},
+		),
+		newFunction(
+			pkg, "змінити_каталог", []types.MethodParameter{stringParameter("шлях")}, types.NilClass,
+			func(_ types.Context, args types.Tuple) (types.Object, error) {
+				if err := os.Chdir(goString(args[0])); err != nil {
+					return nil, types.IOErrorFromGo(err)
+				}
+
+				return types.Nil, nil
+			},
+		),
+		types.FunctionNew(
+			"виконати",
+			pkg,
+			[]types.MethodParameter{
+				stringParameter("програма"),
+				{
+					Class:      types.ListClass,
+					Classes:    []*types.Class{types.TupleClass},
+					Name:       "аргументи",
+					IsNullable: false,
+					IsVariadic: false,
+				},
+			},
+			[]types.MethodReturnType{
+				{
+					Class:      types.IntClass,
+					IsNullable: false,
+				},
+				{
+					Class:      types.StringClass,
+					IsNullable: false,
+				},
+				{
+					Class:      types.StringClass,
+					IsNullable: false,
+				},
+			},
+			func(ctx types.Context, args types.Tuple, _ types.StringDict) (types.Object, error) {
+				return execute(ctx, args)
+			},
+		),
+	}
+
+	dict := types.StringDict{
+		"аргументи":   stringList(arguments),
+		"платформа":   types.String(runtime.GOOS),
+		"архітектура": types.String(runtime.GOARCH),
+	}
+
+	for _, function := range functions {
+		dict[function.Name] = function
+	}
+
+	return dict
+}
@@ -0,0 +1,140 @@
+package packages
+
+import (
+	"time"
+	// Time zones are embedded, so they can be loaded on systems without
+	// the time zone database.
+	_ "time/tzdata"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+)
+
+const TimePackageName = "час"
+
+// start is the moment of the start of the program, which contains the
+// reading of the monotonic clock.
+var start = time.Now()
+
+func durationParameter(name string) types.MethodParameter {
+	return types.MethodParameter{
+		Class:      types.DurationClass,
+		Classes:    []*types.Class{types.IntClass, types.RealClass, types.FractionClass, types.DecimalClass},
+		Name:       name,
+		IsNullable: false,
+		IsVariadic: false,
+	}
+}
+
+func toDuration(ctx types.Context, arg types.Object) (types.Duration, error) {
+	if d, ok := arg.(types.Duration); ok {
+		return d, nil
+	}
+
+	return types.DurationFromSeconds(ctx, arg)
+}
+
+// MakeTime creates functions and constants of the package for working
+// with dates, durations and time zones. Templates of formatting and
+// parsing are described in types.FormatTime.
+func MakeTime(pkg *types.Package) types.StringDict {
+	dict := types.StringDict{
+		types.DateTimeClass.Name: types.DateTimeClass,
+		types.DurationClass.Name: types.DurationClass,
+
+		"наносекунда":  types.Duration(time.Nanosecond),
+		"мікросекунда": types.Duration(time.Microsecond),
+		"мілісекунда":  types.Duration(time.Millisecond),
+		"секунда":      types.Duration(time.Second),
+		"хвилина":      types.Duration(time.Minute),
+		"година":       types.Duration(time.Hour),
+		"день":         types.Duration(24 * time.Hour),
+		"тиждень":      types.Duration(7 * 24 * time.Hour),
+
+		"місяці":            stringList(types.MonthNames),
+		"місяці_родовий":    stringList(types.MonthGenitiveNames),
+		"місяці_короткі":    stringList(types.MonthShortNames),
+		"дні_тижня":         stringList(types.WeekdayNames),
+		"дні_тижня_короткі": stringList(types.WeekdayShortNames),
+	}
+
+	functions := []*types.Method{
+		newFunction(
+			pkg, "зараз", nil, types.DateTimeClass,
+			func(_ types.Context, _ types.Tuple) (types.Object, error) {
+				return types.DateTime(time.Now().Round(0)), nil
+			},
+		),
+		newFunction(
+			pkg, "зараз_у_зоні", []types.MethodParameter{stringParameter("зона")}, types.DateTimeClass,
+			func(_ types.Context, args types.Tuple) (types.Object, error) {
+				location, err := types.LoadLocation(goString(args[0]))
+				if err != nil {
+					return nil, err
+				}
+
+				return types.DateTime(time.Now().Round(0).In(location)), nil
+			},
+		),
+		newFunction(
+			pkg, "монотонний", nil, types.DurationClass,
+			func(_ types.Context, _ types.Tuple) (types.Object, error) {
+				return types.Duration(time.Since(start)), nil
+			},
+		),
+		newFunction(
+			pkg, "спати", []types.MethodParameter{durationParameter("тривалість")}, types.NilClass,
+			func(ctx types.Context, args types.Tuple) (types.Object, error) {
+				d, err := toDuration(ctx, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				if d < 0 {
+					return nil, types.NewValueErrorf("тривалість сну не може бути від'ємною")
+				}
+
+				// The output printed before must be visible while sleeping.
+				if err := types.FlushStandardStreams(); err != nil {
+					return nil, err
+				}
+
+				time.Sleep(time.Duration(d))
+				return types.Nil, nil
+			},
+		),
+		newFunction(
+			pkg,
+			"з_мітки_часу",
+			[]types.MethodParameter{numberParameter("секунди")},
+			types.DateTimeClass,
+			func(ctx types.Context, args types.Tuple) (types.Object, error) {
+				d, err := types.DurationFromSeconds(ctx, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				return types.DateTime(time.Unix(0, int64(d))), nil
+			},
+		),
+		newFunction(
+			pkg,
+			"розібрати",
+			[]types.MethodParameter{stringParameter("рядок"), stringParameter("шаблон")},
+			types.DateTimeClass,
+			func(_ types.Context, args types.Tuple) (types.Object, error) {
+				t, err := types.ParseTime(goString(args[0]), goString(args[1]), time.Local)
+				if err != nil {
+					return nil, err
+				}
+
+				return types.DateTime(t), nil
+			},
+		),
+	}
+
+	for _, function := range functions {
+		dict[function.Name] = function
+	}
+
+	return dict
+}
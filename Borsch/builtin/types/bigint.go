@@ -1,6 +1,517 @@
 package types
 
+import (
+	"hash/fnv"
+	"math"
+	"math/big"
+)
+
+// BigInt is an arbitrary-precision integer. It has the same class
+// as Int and is used only for values which don't fit into Int, so
+// results of operations are converted back to Int whenever possible.
+type BigInt big.Int
+
+func (value *BigInt) Class() *Class {
+	return IntClass
+}
+
+// Big returns the value as *big.Int, which must not be modified.
+func (value *BigInt) Big() *big.Int {
+	return (*big.Int)(value)
+}
+
+// MaybeInt returns Int if the value fits into it, or the value itself.
+func (value *BigInt) MaybeInt() Object {
+	if value.Big().IsInt64() {
+		return Int(value.Big().Int64())
+	}
+
+	return value
+}
+
+func newBigInt(x *big.Int) Object {
+	return (*BigInt)(x).MaybeInt()
+}
+
+// IntFromBig converts x to Int if it fits into it, or to BigInt
+// otherwise. x must not be modified after the call.
+func IntFromBig(x *big.Int) Object {
+	return newBigInt(x)
+}
+
+func bigIntFromInt(value Int) *big.Int {
+	return big.NewInt(int64(value))
+}
+
+// bigIntOperand converts an integer operand, i.e. Int, BigInt or Bool,
+// to *big.Int.
+func bigIntOperand(other Object) (*big.Int, bool) {
+	switch other := other.(type) {
+	case Int:
+		return bigIntFromInt(other), true
+	case *BigInt:
+		return other.Big(), true
+	case Bool:
+		return bigIntFromInt(bo2io(other)), true
+	}
+
+	return nil, false
+}
+
+func bigIntToReal(x *big.Int) (Real, error) {
+	f, _ := new(big.Float).SetInt(x).Float64()
+	if math.IsInf(f, 0) {
+		return 0, NewOverflowErrorf("ціле число занадто велике, щоб перетворити його в дійсне")
+	}
+
+	return Real(f), nil
+}
+
+// compareBigIntWithReal compares the integer with the real number
+// without loss of precision, ok is false if the real number is NaN.
+func compareBigIntWithReal(x *big.Int, r Real) (result int, ok bool) {
+	if math.IsNaN(float64(r)) {
+		return 0, false
+	}
+
+	return new(big.Float).SetInt(x).Cmp(big.NewFloat(float64(r))), true
+}
+
+// The following functions perform operations on Int and promote
+// the result to BigInt if it overflows.
+
+func addInt(a, b Int) Object {
+	if r := a + b; (r > a) == (b > 0) {
+		return r
+	}
+
+	return newBigInt(new(big.Int).Add(bigIntFromInt(a), bigIntFromInt(b)))
+}
+
+func subInt(a, b Int) Object {
+	if r := a - b; (r < a) == (b > 0) {
+		return r
+	}
+
+	return newBigInt(new(big.Int).Sub(bigIntFromInt(a), bigIntFromInt(b)))
+}
+
+func mulInt(a, b Int) Object {
+	if a == 0 || b == 0 {
+		return Int(0)
+	}
+
+	r := a * b
+	if r/b == a && !(a == -1 && b == math.MinInt64) && !(b == -1 && a == math.MinInt64) {
+		return r
+	}
+
+	return newBigInt(new(big.Int).Mul(bigIntFromInt(a), bigIntFromInt(b)))
+}
+
+func negateInt(a Int) Object {
+	if a == math.MinInt64 {
+		return newBigInt(new(big.Int).Neg(bigIntFromInt(a)))
+	}
+
+	return -a
+}
+
+func shiftLeftInt(a, b Int) (Object, error) {
+	if b < 0 {
+		return nil, NewValueErrorf("від'ємна кількість зсуву")
+	}
+
+	if a == 0 {
+		return a, nil
+	}
+
+	if b < 63 && (a<<b)>>b == a {
+		return a << b, nil
+	}
+
+	return shiftLeftBigInt(bigIntFromInt(a), bigIntFromInt(b))
+}
+
+func shiftRightInt(a, b Int) (Object, error) {
+	if b < 0 {
+		return nil, NewValueErrorf("від'ємна кількість зсуву")
+	}
+
+	return a >> b, nil
+}
+
+func shiftLeftBigInt(a, b *big.Int) (Object, error) {
+	if b.Sign() < 0 {
+		return nil, NewValueErrorf("від'ємна кількість зсуву")
+	}
+
+	if a.Sign() == 0 {
+		return Int(0), nil
+	}
+
+	if !b.IsInt64() || b.Int64() > math.MaxInt32 {
+		return nil, NewOverflowErrorf("занадто велика кількість зсуву")
+	}
+
+	return newBigInt(new(big.Int).Lsh(a, uint(b.Int64()))), nil
+}
+
+func shiftRightBigInt(a, b *big.Int) (Object, error) {
+	if b.Sign() < 0 {
+		return nil, NewValueErrorf("від'ємна кількість зсуву")
+	}
+
+	if !b.IsInt64() || b.Int64() > int64(a.BitLen()) {
+		if a.Sign() < 0 {
+			return Int(-1), nil
+		}
+
+		return Int(0), nil
+	}
+
+	return newBigInt(new(big.Int).Rsh(a, uint(b.Int64()))), nil
+}
+
+// powBigInt raises the integer to the integer power, the result
+// is real if the exponent is negative.
+func powBigInt(a, b *big.Int) (Object, error) {
+	if b.Sign() < 0 {
+		if a.Sign() == 0 {
+			return nil, NewZeroDivisionError("неможливо піднести 0 до від'ємного степеня")
+		}
+
+		base, err := bigIntToReal(a)
+		if err != nil {
+			return nil, err
+		}
+
+		exponent, _ := new(big.Float).SetInt(b).Float64()
+		return Real(math.Pow(float64(base), exponent)), nil
+	}
+
+	if !b.IsInt64() && a.CmpAbs(big.NewInt(1)) > 0 {
+		return nil, NewOverflowErrorf("занадто великий показник степеня")
+	}
+
+	return newBigInt(new(big.Int).Exp(a, b, nil)), nil
+}
+
+func divBigInt(a, b *big.Int) (Object, error) {
+	if b.Sign() == 0 {
+		return nil, NewZeroDivisionError("ділення на нуль")
+	}
+
+	f, _ := new(big.Rat).SetFrac(a, b).Float64()
+	if math.IsInf(f, 0) {
+		return nil, NewOverflowErrorf("результат ділення цілих чисел занадто великий для дійсного числа")
+	}
+
+	return Real(f), nil
+}
+
+func modBigInt(a, b *big.Int) (Object, error) {
+	if b.Sign() == 0 {
+		return nil, NewZeroDivisionError("цілочисельне ділення або за модулем на нуль")
+	}
+
+	return newBigInt(new(big.Int).Rem(a, b)), nil
+}
+
+// operation performs the binary operation with the integer operand
+// using intOp, or with the real operand converting the value to Real
+// and using realOp. The value is the right operand if 'reversed' is true.
+// Other operands are not supported, so (nil, nil) is returned.
+func (value *BigInt) operation(
+	ctx Context,
+	other Object,
+	reversed bool,
+	intOp func(a, b *big.Int) (Object, error),
+	realOp func(Real, Context, Object) (Object, error),
+) (Object, error) {
+	if otherValue, ok := bigIntOperand(other); ok {
+		if reversed {
+			return intOp(otherValue, value.Big())
+		}
+
+		return intOp(value.Big(), otherValue)
+	}
+
+	if otherValue, ok := other.(Real); ok && realOp != nil {
+		realValue, err := bigIntToReal(value.Big())
+		if err != nil {
+			return nil, err
+		}
+
+		return realOp(realValue, ctx, otherValue)
+	}
+
+	return nil, nil
+}
+
+func bigIntFunc(f func(z, a, b *big.Int) *big.Int) func(a, b *big.Int) (Object, error) {
+	return func(a, b *big.Int) (Object, error) {
+		return newBigInt(f(new(big.Int), a, b)), nil
+	}
+}
+
 var (
-	// TODO: OverflowError
-	overflowErrorGo *Error
+	bigIntAdd = bigIntFunc((*big.Int).Add)
+	bigIntSub = bigIntFunc((*big.Int).Sub)
+	bigIntMul = bigIntFunc((*big.Int).Mul)
+	bigIntOr  = bigIntFunc((*big.Int).Or)
+	bigIntXor = bigIntFunc((*big.Int).Xor)
+	bigIntAnd = bigIntFunc((*big.Int).And)
 )
+
+func (value *BigInt) represent(ctx Context) (Object, error) {
+	return value.string(ctx)
+}
+
+func (value *BigInt) string(Context) (Object, error) {
+	return String(value.Big().String()), nil
+}
+
+func (value *BigInt) toBool(Context) (Object, error) {
+	return gb2bo(value.Big().Sign() != 0), nil
+}
+
+func (value *BigInt) hash(Context) (Object, error) {
+	// Equal numbers must have equal hashes, i.e. 2.0 ** 70 and 2 ** 70.
+	f, accuracy := new(big.Float).SetInt(value.Big()).Float64()
+	if accuracy == big.Exact && !math.IsInf(f, 0) {
+		return Real(f).hash(nil)
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write(value.Big().Bytes())
+	if value.Big().Sign() < 0 {
+		return Int(^h.Sum64()), nil
+	}
+
+	return Int(h.Sum64()), nil
+}
+
+func (value *BigInt) toInt(Context) (Object, error) {
+	return value, nil
+}
+
+func (value *BigInt) toReal(Context) (Object, error) {
+	return bigIntToReal(value.Big())
+}
+
+func (value *BigInt) toGoInt(Context) (int, error) {
+	return 0, NewOverflowErrorf("ціле число занадто велике, щоб перетворити його в Go int")
+}
+
+func (value *BigInt) add(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, bigIntAdd, Real.add)
+}
+
+func (value *BigInt) reversedAdd(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, bigIntAdd, Real.reversedAdd)
+}
+
+func (value *BigInt) sub(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, bigIntSub, Real.sub)
+}
+
+func (value *BigInt) reversedSub(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, bigIntSub, Real.reversedSub)
+}
+
+func (value *BigInt) div(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, divBigInt, Real.div)
+}
+
+func (value *BigInt) reversedDiv(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, divBigInt, Real.reversedDiv)
+}
+
+func (value *BigInt) mul(ctx Context, other Object) (Object, error) {
+	switch other.(type) {
+	case String, *Tuple, *List:
+		return nil, NewOverflowErrorf("неможливо повторити об'єкт '%s' таку кількість разів", other.Class().Name)
+	}
+
+	return value.operation(ctx, other, false, bigIntMul, Real.mul)
+}
+
+func (value *BigInt) reversedMul(ctx Context, other Object) (Object, error) {
+	switch other.(type) {
+	case String, *Tuple, *List:
+		return nil, NewOverflowErrorf("неможливо повторити об'єкт '%s' таку кількість разів", other.Class().Name)
+	}
+
+	return value.operation(ctx, other, true, bigIntMul, Real.reversedMul)
+}
+
+func (value *BigInt) mod(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, modBigInt, Real.mod)
+}
+
+func (value *BigInt) reversedMod(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, modBigInt, Real.reversedMod)
+}
+
+func (value *BigInt) pow(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, powBigInt, Real.pow)
+}
+
+func (value *BigInt) reversedPow(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, powBigInt, Real.reversedPow)
+}
+
+// isNumber checks whether the object is an integer or a real number.
+func isNumber(other Object) bool {
+	switch other.(type) {
+	case Int, *BigInt, Bool, Real:
+		return true
+	}
+
+	return false
+}
+
+// compare compares the value with the number, ok is false if the
+// number is NaN.
+func (value *BigInt) compare(other Object) (result int, ok bool) {
+	if otherValue, isReal := other.(Real); isReal {
+		return compareBigIntWithReal(value.Big(), otherValue)
+	}
+
+	otherValue, _ := bigIntOperand(other)
+	return value.Big().Cmp(otherValue), true
+}
+
+// comparison compares the value with the number using the predicate.
+func (value *BigInt) comparison(other Object, predicate func(result int, ok bool) bool) (Object, error) {
+	if !isNumber(other) {
+		return nil, nil
+	}
+
+	return gb2bo(predicate(value.compare(other))), nil
+}
+
+// ordering is comparison for ordering operators, which fails if the
+// object is not a number.
+func (value *BigInt) ordering(
+	operator string,
+	other Object,
+	predicate func(result int, ok bool) bool,
+) (Object, error) {
+	if !isNumber(other) {
+		return unorderable(operator, value, other)
+	}
+
+	return value.comparison(other, predicate)
+}
+
+func (value *BigInt) equals(_ Context, other Object) (Object, error) {
+	return value.comparison(
+		other, func(result int, ok bool) bool {
+			return ok && result == 0
+		},
+	)
+}
+
+func (value *BigInt) notEquals(_ Context, other Object) (Object, error) {
+	return value.comparison(
+		other, func(result int, ok bool) bool {
+			return !ok || result != 0
+		},
+	)
+}
+
+func (value *BigInt) less(_ Context, other Object) (Object, error) {
+	return value.ordering(
+		"<", other, func(result int, ok bool) bool {
+			return ok && result < 0
+		},
+	)
+}
+
+func (value *BigInt) lessOrEquals(_ Context, other Object) (Object, error) {
+	return value.ordering(
+		"<=", other, func(result int, ok bool) bool {
+			return ok && result <= 0
+		},
+	)
+}
+
+func (value *BigInt) greater(_ Context, other Object) (Object, error) {
+	return value.ordering(
+		">", other, func(result int, ok bool) bool {
+			return ok && result > 0
+		},
+	)
+}
+
+func (value *BigInt) greaterOrEquals(_ Context, other Object) (Object, error) {
+	return value.ordering(
+		">=", other, func(result int, ok bool) bool {
+			return ok && result >= 0
+		},
+	)
+}
+
+func (value *BigInt) shiftLeft(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, shiftLeftBigInt, nil)
+}
+
+func (value *BigInt) reversedShiftLeft(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, shiftLeftBigInt, nil)
+}
+
+func (value *BigInt) shiftRight(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, shiftRightBigInt, nil)
+}
+
+func (value *BigInt) reversedShiftRight(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, shiftRightBigInt, nil)
+}
+
+func (value *BigInt) bitwiseOr(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, bigIntOr, nil)
+}
+
+func (value *BigInt) reversedBitwiseOr(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, bigIntOr, nil)
+}
+
+func (value *BigInt) bitwiseXor(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, bigIntXor, nil)
+}
+
+func (value *BigInt) reversedBitwiseXor(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, bigIntXor, nil)
+}
+
+func (value *BigInt) bitwiseAnd(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, bigIntAnd, nil)
+}
+
+func (value *BigInt) reversedBitwiseAnd(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, bigIntAnd, nil)
+}
+
+func (value *BigInt) positive(_ Context) (Object, error) {
+	return value, nil
+}
+
+func (value *BigInt) negate(_ Context) (Object, error) {
+	return newBigInt(new(big.Int).Neg(value.Big())), nil
+}
+
+func (value *BigInt) invert(_ Context) (Object, error) {
+	return newBigInt(new(big.Int).Not(value.Big())), nil
+}
+
+// smallInt returns the value of 'ціле' object as Int, the error is
+// returned if the value doesn't fit into Int.
+func smallInt(value Object) (Int, error) {
+	if bigValue, ok := value.(*BigInt); ok {
+		return 0, NewOverflowErrorf("ціле число %s занадто велике", bigValue.Big())
+	}
+
+	return value.(Int), nil
+}
@@ -78,75 +78,99 @@ func (value Bool) toReal(Context) (Object, error) {
 	return Real(0.0), nil
 }
 
+func (value Bool) hash(Context) (Object, error) {
+	return bo2io(value), nil
+}
+
 func (value Bool) toInt(ctx Context) (Object, error) {
 	return bo2io(value), nil
 }
 
-func (value Bool) add(_ Context, other Object) (Object, error) {
+func (value Bool) add(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedAdd(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return bo2io(value) + bo2io(otherValue), nil
 	}
 
 	if otherValue, ok := other.(Int); ok {
-		return bo2io(value) + otherValue, nil
+		return addInt(bo2io(value), otherValue), nil
 	}
 
 	if otherValue, ok := other.(Real); ok {
 		return bo2ro(value) + otherValue, nil
 	}
 
-	return nil, NewErrorf("неможливо виконати додавання логічного значення до об'єкта '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Bool) reversedAdd(_ Context, other Object) (Object, error) {
+func (value Bool) reversedAdd(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.add(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return bo2io(otherValue) + bo2io(value), nil
 	}
 
 	if otherValue, ok := other.(Int); ok {
-		return otherValue + bo2io(value), nil
+		return addInt(otherValue, bo2io(value)), nil
 	}
 
 	if otherValue, ok := other.(Real); ok {
 		return otherValue + bo2ro(value), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати додавання об'єкта '%s' до логічне значення", other.Class().Name)
+	return nil, nil
 }
 
-func (value Bool) sub(_ Context, other Object) (Object, error) {
+func (value Bool) sub(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedSub(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return bo2io(value) - bo2io(otherValue), nil
 	}
 
 	if otherValue, ok := other.(Int); ok {
-		return bo2io(value) - otherValue, nil
+		return subInt(bo2io(value), otherValue), nil
 	}
 
 	if otherValue, ok := other.(Real); ok {
 		return bo2ro(value) - otherValue, nil
 	}
 
-	return nil, NewErrorf("неможливо виконати віднімання логічного значення від об'єкта '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Bool) reversedSub(_ Context, other Object) (Object, error) {
+func (value Bool) reversedSub(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.sub(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return bo2io(otherValue) - bo2io(value), nil
 	}
 
 	if otherValue, ok := other.(Int); ok {
-		return otherValue - bo2io(value), nil
+		return subInt(otherValue, bo2io(value)), nil
 	}
 
 	if otherValue, ok := other.(Real); ok {
 		return otherValue - bo2ro(value), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати віднімання об'єкта '%s' від логічне значення", other.Class().Name)
+	return nil, nil
 }
 
-func (value Bool) div(_ Context, other Object) (Object, error) {
+func (value Bool) div(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedDiv(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		if !otherValue {
 			return nil, NewZeroDivisionError("ділення на нуль")
@@ -171,10 +195,14 @@ func (value Bool) div(_ Context, other Object) (Object, error) {
 		return bo2ro(value) / otherValue, nil
 	}
 
-	return nil, NewErrorf("неможливо виконати ділення логічного значення на об'єкт '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Bool) reversedDiv(_ Context, other Object) (Object, error) {
+func (value Bool) reversedDiv(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.div(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		if !value {
 			return nil, NewZeroDivisionError("ділення на нуль")
@@ -199,10 +227,14 @@ func (value Bool) reversedDiv(_ Context, other Object) (Object, error) {
 		return otherValue, nil
 	}
 
-	return nil, NewErrorf("неможливо виконати ділення об'єкта '%s' на логічне значення", other.Class().Name)
+	return nil, nil
 }
 
-func (value Bool) mul(_ Context, other Object) (Object, error) {
+func (value Bool) mul(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedMul(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return bo2io(value && otherValue), nil
 	}
@@ -230,10 +262,14 @@ func (value Bool) mul(_ Context, other Object) (Object, error) {
 		return otherValue, nil
 	}
 
-	return nil, NewErrorf("неможливо виконати множення логічного значення на об'єкт '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Bool) reversedMul(_ Context, other Object) (Object, error) {
+func (value Bool) reversedMul(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.mul(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return bo2io(otherValue && value), nil
 	}
@@ -255,10 +291,14 @@ func (value Bool) reversedMul(_ Context, other Object) (Object, error) {
 		return otherValue, nil
 	}
 
-	return nil, NewErrorf("неможливо виконати множення об'єкта '%s' на логічне значення", other.Class().Name)
+	return nil, nil
 }
 
-func (value Bool) mod(_ Context, other Object) (Object, error) {
+func (value Bool) mod(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedMod(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		if !otherValue {
 			return nil, NewZeroDivisionError("цілочисельне ділення або за модулем на нуль")
@@ -284,10 +324,14 @@ func (value Bool) mod(_ Context, other Object) (Object, error) {
 		return mod(bo2ro(value), otherValue), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати модуль? логічного значення  '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Bool) reversedMod(_ Context, other Object) (Object, error) {
+func (value Bool) reversedMod(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.mod(ctx, value)
+	}
+
 	switch other.(type) {
 	case Bool, Int:
 		if !value {
@@ -303,10 +347,14 @@ func (value Bool) reversedMod(_ Context, other Object) (Object, error) {
 		return Real(0.0), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати модуль? об'єкта '%s'  логічне значення", other.Class().Name)
+	return nil, nil
 }
 
-func (value Bool) pow(_ Context, other Object) (Object, error) {
+func (value Bool) pow(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedPow(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return bo2io(!(!value && otherValue)), nil
 	}
@@ -346,10 +394,14 @@ func (value Bool) pow(_ Context, other Object) (Object, error) {
 		return Real(0.0), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати обчислення логічного значення в степені '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Bool) reversedPow(_ Context, other Object) (Object, error) {
+func (value Bool) reversedPow(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.pow(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return bo2io(!(!otherValue && value)), nil
 	}
@@ -368,10 +420,14 @@ func (value Bool) reversedPow(_ Context, other Object) (Object, error) {
 		return Real(1.0), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати обчислення об'єкта '%s' в степені логічного значення", other.Class().Name)
+	return nil, nil
 }
 
-func (value Bool) equals(_ Context, other Object) (Object, error) {
+func (value Bool) equals(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.equals(ctx, value)
+	}
+
 	if v, ok := other.(Bool); ok {
 		return gb2bo(value == v), nil
 	}
@@ -384,10 +440,14 @@ func (value Bool) equals(_ Context, other Object) (Object, error) {
 		return gb2bo(bo2ro(value) == v), nil
 	}
 
-	return False, nil
+	return nil, nil
 }
 
-func (value Bool) notEquals(_ Context, other Object) (Object, error) {
+func (value Bool) notEquals(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.notEquals(ctx, value)
+	}
+
 	if v, ok := other.(Bool); ok {
 		return gb2bo(value != v), nil
 	}
@@ -404,10 +464,14 @@ func (value Bool) notEquals(_ Context, other Object) (Object, error) {
 		return True, nil
 	}
 
-	return False, nil
+	return nil, nil
 }
 
-func (value Bool) less(_ Context, other Object) (Object, error) {
+func (value Bool) less(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.greater(ctx, value)
+	}
+
 	if v, ok := other.(Bool); ok {
 		return gb2bo(!bool(value) && bool(v)), nil
 	}
@@ -420,10 +484,14 @@ func (value Bool) less(_ Context, other Object) (Object, error) {
 		return gb2bo(bo2ro(value) < v), nil
 	}
 
-	return nil, OperatorNotSupportedErrorNew("<", value.Class().Name, other.Class().Name)
+	return unorderable("<", value, other)
 }
 
-func (value Bool) lessOrEquals(_ Context, other Object) (Object, error) {
+func (value Bool) lessOrEquals(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.greaterOrEquals(ctx, value)
+	}
+
 	if v, ok := other.(Bool); ok {
 		return gb2bo(!(bool(value) && !bool(v))), nil
 	}
@@ -436,10 +504,14 @@ func (value Bool) lessOrEquals(_ Context, other Object) (Object, error) {
 		return gb2bo(bo2ro(value) <= v), nil
 	}
 
-	return nil, OperatorNotSupportedErrorNew("<=", value.Class().Name, other.Class().Name)
+	return unorderable("<=", value, other)
 }
 
-func (value Bool) greater(_ Context, other Object) (Object, error) {
+func (value Bool) greater(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.less(ctx, value)
+	}
+
 	if v, ok := other.(Bool); ok {
 		return gb2bo(bool(value) && !bool(v)), nil
 	}
@@ -452,10 +524,14 @@ func (value Bool) greater(_ Context, other Object) (Object, error) {
 		return gb2bo(bo2ro(value) > v), nil
 	}
 
-	return nil, OperatorNotSupportedErrorNew(">", value.Class().Name, other.Class().Name)
+	return unorderable(">", value, other)
 }
 
-func (value Bool) greaterOrEquals(_ Context, other Object) (Object, error) {
+func (value Bool) greaterOrEquals(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.lessOrEquals(ctx, value)
+	}
+
 	if v, ok := other.(Bool); ok {
 		return gb2bo(bool(value) || !bool(v)), nil
 	}
@@ -468,70 +544,78 @@ func (value Bool) greaterOrEquals(_ Context, other Object) (Object, error) {
 		return gb2bo(bo2ro(value) >= v), nil
 	}
 
-	return nil, OperatorNotSupportedErrorNew(">=", value.Class().Name, other.Class().Name)
+	return unorderable(">=", value, other)
 }
 
-func (value Bool) shiftLeft(_ Context, other Object) (Object, error) {
+func (value Bool) shiftLeft(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedShiftLeft(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
-		return bo2io(value) << bo2io(otherValue), nil
+		return shiftLeftInt(bo2io(value), bo2io(otherValue))
 	}
 
 	if otherValue, ok := other.(Int); ok {
-		return bo2io(value) << otherValue, nil
+		return shiftLeftInt(bo2io(value), otherValue)
 	}
 
-	return nil, NewErrorf(
-		"неможливо виконати побітовий зсув ліворуч логічного значення на об'єкт '%s'",
-		other.Class().Name,
-	)
+	return nil, nil
 }
 
-func (value Bool) reversedShiftLeft(_ Context, other Object) (Object, error) {
+func (value Bool) reversedShiftLeft(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.shiftLeft(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
-		return bo2io(otherValue) << bo2io(value), nil
+		return shiftLeftInt(bo2io(otherValue), bo2io(value))
 	}
 
 	if otherValue, ok := other.(Int); ok {
-		return otherValue << bo2io(value), nil
+		return shiftLeftInt(otherValue, bo2io(value))
 	}
 
-	return nil, NewErrorf(
-		"неможливо виконати побітовий зсув ліворуч об'єкта '%s' на логічне значення",
-		other.Class().Name,
-	)
+	return nil, nil
 }
 
-func (value Bool) shiftRight(_ Context, other Object) (Object, error) {
+func (value Bool) shiftRight(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedShiftRight(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
-		return bo2io(value) >> bo2io(otherValue), nil
+		return shiftRightInt(bo2io(value), bo2io(otherValue))
 	}
 
 	if otherValue, ok := other.(Int); ok {
-		return bo2io(value) >> otherValue, nil
+		return shiftRightInt(bo2io(value), otherValue)
 	}
 
-	return nil, NewErrorf(
-		"неможливо виконати побітовий зсув праворуч логічного значення на об'єкт '%s'",
-		other.Class().Name,
-	)
+	return nil, nil
 }
 
-func (value Bool) reversedShiftRight(_ Context, other Object) (Object, error) {
+func (value Bool) reversedShiftRight(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.shiftRight(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
-		return bo2io(otherValue) >> bo2io(value), nil
+		return shiftRightInt(bo2io(otherValue), bo2io(value))
 	}
 
 	if otherValue, ok := other.(Int); ok {
-		return otherValue >> bo2io(value), nil
+		return shiftRightInt(otherValue, bo2io(value))
 	}
 
-	return nil, NewErrorf(
-		"неможливо виконати побітовий зсув праворуч об'єкта '%s' на логічного значення",
-		other.Class().Name,
-	)
+	return nil, nil
 }
 
-func (value Bool) bitwiseOr(_ Context, other Object) (Object, error) {
+func (value Bool) bitwiseOr(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedBitwiseOr(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return io2bo(bo2io(value) | bo2io(otherValue)), nil
 	}
@@ -539,13 +623,14 @@ func (value Bool) bitwiseOr(_ Context, other Object) (Object, error) {
 		return bo2io(value) | otherValue, nil
 	}
 
-	return nil, NewErrorf(
-		"неможливо виконати побітову диз'юнкцію логічного значення та об'єкта '%s'",
-		other.Class().Name,
-	)
+	return nil, nil
 }
 
-func (value Bool) reversedBitwiseOr(_ Context, other Object) (Object, error) {
+func (value Bool) reversedBitwiseOr(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.bitwiseOr(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return io2bo(bo2io(otherValue) | bo2io(value)), nil
 	}
@@ -554,13 +639,14 @@ func (value Bool) reversedBitwiseOr(_ Context, other Object) (Object, error) {
 		return otherValue | bo2io(value), nil
 	}
 
-	return nil, NewErrorf(
-		"неможливо виконати побітову диз'юнкцію об'єкта '%s' та логічного значення",
-		other.Class().Name,
-	)
+	return nil, nil
 }
 
-func (value Bool) bitwiseXor(_ Context, other Object) (Object, error) {
+func (value Bool) bitwiseXor(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedBitwiseXor(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return io2bo(bo2io(value) ^ bo2io(otherValue)), nil
 	}
@@ -569,13 +655,14 @@ func (value Bool) bitwiseXor(_ Context, other Object) (Object, error) {
 		return bo2io(value) ^ otherValue, nil
 	}
 
-	return nil, NewErrorf(
-		"неможливо виконати побітову виняткову диз'юнкцію логічного значення та об'єкта '%s'",
-		other.Class().Name,
-	)
+	return nil, nil
 }
 
-func (value Bool) reversedBitwiseXor(_ Context, other Object) (Object, error) {
+func (value Bool) reversedBitwiseXor(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.bitwiseXor(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return io2bo(bo2io(otherValue) ^ bo2io(value)), nil
 	}
@@ -584,13 +671,14 @@ func (value Bool) reversedBitwiseXor(_ Context, other Object) (Object, error) {
 		return otherValue ^ bo2io(value), nil
 	}
 
-	return nil, NewErrorf(
-		"неможливо виконати побітову виняткову диз'юнкцію об'єкта '%s' та логічного значення",
-		other.Class().Name,
-	)
+	return nil, nil
 }
 
-func (value Bool) bitwiseAnd(_ Context, other Object) (Object, error) {
+func (value Bool) bitwiseAnd(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedBitwiseAnd(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return io2bo(bo2io(value) & bo2io(otherValue)), nil
 	}
@@ -599,13 +687,14 @@ func (value Bool) bitwiseAnd(_ Context, other Object) (Object, error) {
 		return bo2io(value) & otherValue, nil
 	}
 
-	return nil, NewErrorf(
-		"неможливо виконати побітову кон'юнкцію логічного значення та об'єкта '%s'",
-		other.Class().Name,
-	)
+	return nil, nil
 }
 
-func (value Bool) reversedBitwiseAnd(_ Context, other Object) (Object, error) {
+func (value Bool) reversedBitwiseAnd(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.bitwiseAnd(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return io2bo(bo2io(otherValue) & bo2io(value)), nil
 	}
@@ -614,10 +703,7 @@ func (value Bool) reversedBitwiseAnd(_ Context, other Object) (Object, error) {
 		return otherValue & bo2io(value), nil
 	}
 
-	return nil, NewErrorf(
-		"неможливо виконати побітову кон'юнкцію об'єкта '%s' та логічного значення",
-		other.Class().Name,
-	)
+	return nil, nil
 }
 
 func (value Bool) positive(_ Context) (Object, error) {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
@@ -211,6 +212,11 @@ func (value *Class) string(ctx Context) (Object, error) {
 	return value.represent(ctx)
 }
 
+// hash returns a hash based on identity of the object.
+func (value *Class) hash(_ Context) (Object, error) {
+	return Int(reflect.ValueOf(value).Pointer()), nil
+}
+
 func (value *Class) toBool(ctx Context) (Object, error) {
 	if value.IsInstance() {
 		if attr := value.GetOperatorOrNil(common.BoolOp); attr != nil {
@@ -363,6 +369,107 @@ func (value *Class) lessOrEquals(ctx Context, other Object) (Object, error) {
 	return callBinaryOperator(ctx, value, other, common.LessOrEqualsOp)
 }
 
+func (value *Class) contains(ctx Context, item Object) (Object, error) {
+	if value.IsInstance() {
+		return callBinaryOperator(ctx, value, item, common.ContainsOp)
+	}
+
+	return nil, nil
+}
+
+func (value *Class) iterate(ctx Context) (Object, error) {
+	if value.IsInstance() {
+		if attr := value.GetOperatorOrNil(common.IteratorOp); attr != nil {
+			return Call(ctx, attr, Tuple{value})
+		}
+
+		// An iterator is iterable by itself.
+		if value.GetOperatorOrNil(common.NextOp) != nil {
+			return value, nil
+		}
+	}
+
+	// Marks that method could not be executed due to incorrect arguments.
+	// Caller should return the default error message in this case.
+	return nil, nil
+}
+
+func (value *Class) next(ctx Context) (Object, error) {
+	if value.IsInstance() {
+		return callUnaryOperator(ctx, value, common.NextOp)
+	}
+
+	return nil, nil
+}
+
+func (value *Class) copy(ctx Context) (Object, error) {
+	if !value.IsInstance() {
+		return nil, nil
+	}
+
+	if value.GetOperatorOrNil(common.CopyOp) != nil {
+		return callUnaryOperator(ctx, value, common.CopyOp)
+	}
+
+	return value.copyInstance(
+		nil, func(attr Object) (Object, error) {
+			return attr, nil
+		},
+	)
+}
+
+func (value *Class) deepCopy(ctx Context, memo map[Object]Object) (Object, error) {
+	if !value.IsInstance() {
+		return nil, nil
+	}
+
+	if value.GetOperatorOrNil(common.DeepCopyOp) != nil {
+		result, err := callUnaryOperator(ctx, value, common.DeepCopyOp)
+		if err != nil {
+			return nil, err
+		}
+
+		memo[value] = result
+		return result, nil
+	}
+
+	return value.copyInstance(
+		memo, func(attr Object) (Object, error) {
+			return deepCopy(ctx, attr, memo)
+		},
+	)
+}
+
+// copyInstance creates a new instance of the same class with the
+// attributes produced by copyAttribute. Methods bound to the original
+// instance are bound to the new one. If memo is not nil, the new
+// instance is registered in it before copying the attributes.
+func (value *Class) copyInstance(
+	memo map[Object]Object,
+	copyAttribute func(attr Object) (Object, error),
+) (Object, error) {
+	instance := value.ClassType.allocate(StringDict{})
+	if memo != nil {
+		memo[value] = instance
+	}
+
+	for name, attr := range value.Dict {
+		if wrapper, ok := attr.(*MethodWrapper); ok && wrapper.Instance == value {
+			instance.Dict[name] = wrap(instance, wrapper.Method)
+			continue
+		}
+
+		attrCopy, err := copyAttribute(attr)
+		if err != nil {
+			return nil, err
+		}
+
+		instance.Dict[name] = attrCopy
+	}
+
+	return instance, nil
+}
+
 func (value *Class) Length(ctx Context) (Int, error) {
 	if value.IsInstance() {
 		result, err := callUnaryOperator(ctx, value, common.LengthOp)
@@ -371,12 +478,12 @@ func (value *Class) Length(ctx Context) (Int, error) {
 		}
 
 		if result != nil {
-			intResult, err := ToInt(ctx, result)
+			intResult, err := ToGoInt(ctx, result)
 			if err != nil {
 				return -1, err
 			}
 
-			return intResult.(Int), nil
+			return Int(intResult), nil
 		}
 	}
 
@@ -534,6 +641,14 @@ func excessArgs(args Tuple) bool {
 // The caller should return the default error message in this case.
 func callBinaryOperator(ctx Context, a, b Object, opHash common.OperatorHash) (Object, error) {
 	if attr := a.Class().GetOperatorOrNil(opHash); attr != nil {
+		// The operator which does not accept the second operand is not
+		// executed, so the reflected operator of the operand can be tried.
+		if method, ok := attr.(*Method); ok && len(method.Parameters) == 2 {
+			if checkArg(&method.Parameters[1], b) != nil {
+				return nil, nil
+			}
+		}
+
 		return Call(ctx, attr, Tuple{a, b})
 	}
 
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ukrainianAlphabet lists lower-case letters of the Ukrainian
+// alphabet in their alphabetical order.
+const ukrainianAlphabet = "абвгґдеєжзиіїйклмнопрстуфхцчшщьюя"
+
+var ukrainianLetterIndices = map[rune]uint64{}
+
+func init() {
+	index := uint64(0)
+	for _, r := range ukrainianAlphabet {
+		ukrainianLetterIndices[r] = index
+		index++
+	}
+}
+
+// isApostrophe checks whether the rune is one of the characters
+// used as an apostrophe in Ukrainian words, i.e. "м'ята".
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’' || r == 'ʼ'
+}
+
+// collationWeight returns the primary weight of the rune, which
+// doesn't depend on its case. Ukrainian letters are placed in the
+// alphabetical order right after the Cyrillic 'а', other characters
+// keep the order of their code points.
+func collationWeight(r rune) uint64 {
+	r = unicode.ToLower(r)
+	if index, ok := ukrainianLetterIndices[r]; ok {
+		return uint64('а')<<8 | index
+	}
+
+	return uint64(r) << 8
+}
+
+func compareWeights(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareLetters compares strings alphabetically by the primary
+// weights of their characters, ignoring case and apostrophes.
+func compareLetters(a, b []rune) int {
+	i, j := 0, 0
+	for {
+		for i < len(a) && isApostrophe(a[i]) {
+			i++
+		}
+
+		for j < len(b) && isApostrophe(b[j]) {
+			j++
+		}
+
+		if i == len(a) || j == len(b) {
+			return compareWeights(uint64(len(a)-i), uint64(len(b)-j))
+		}
+
+		if result := compareWeights(collationWeight(a[i]), collationWeight(b[j])); result != 0 {
+			return result
+		}
+
+		i++
+		j++
+	}
+}
+
+// compareCases compares strings of the same letters by the case
+// of the letters, lower-case letters go first.
+func compareCases(a, b []rune) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		aUpper, bUpper := unicode.IsUpper(a[i]), unicode.IsUpper(b[i])
+		if aUpper != bUpper {
+			if aUpper {
+				return 1
+			}
+
+			return -1
+		}
+	}
+
+	return 0
+}
+
+// Collate compares strings using the Ukrainian alphabetical order
+// and returns -1, 0 or 1. Strings are compared by letters first, then
+// by the case of letters and finally by code points, so the result
+// is 0 only for equal strings. If 'ignoreCase' is true, the case of
+// letters is not taken into account.
+func Collate(a, b string, ignoreCase bool) int {
+	if ignoreCase {
+		a, b = strings.ToLower(a), strings.ToLower(b)
+	}
+
+	aRunes, bRunes := []rune(a), []rune(b)
+	if result := compareLetters(aRunes, bRunes); result != 0 {
+		return result
+	}
+
+	if !ignoreCase {
+		if result := compareCases(aRunes, bRunes); result != 0 {
+			return result
+		}
+	}
+
+	return strings.Compare(a, b)
+}
+
+// toTitle converts the first letter of each word to upper case and
+// the rest of letters to lower case. Apostrophes don't separate words.
+func toTitle(s string) string {
+	var builder strings.Builder
+	inWord := false
+	for _, r := range s {
+		if inWord {
+			builder.WriteRune(unicode.ToLower(r))
+		} else {
+			builder.WriteRune(unicode.ToTitle(r))
+		}
+
+		inWord = unicode.IsLetter(r) || unicode.IsDigit(r) || (inWord && isApostrophe(r))
+	}
+
+	return builder.String()
+}
+
+// capitalize converts the first character of the string to upper
+// case and the rest of letters to lower case.
+func capitalize(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return s
+	}
+
+	return string(unicode.ToTitle(r)) + strings.ToLower(s[size:])
+}
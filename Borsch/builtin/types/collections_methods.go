@@ -0,0 +1,422 @@
+package types
+
+type collectionMethodFunc func(ctx Context, self Object, args Tuple) (Object, error)
+
+// newCollectionMethod creates a method of collection classes, the first
+// parameter of which is the collection itself.
+func newCollectionMethod(
+	pkg *Package,
+	name string,
+	selfClasses []*Class,
+	parameters []MethodParameter,
+	returnType *Class,
+	f collectionMethodFunc,
+) *Method {
+	return MethodNew(
+		name,
+		pkg,
+		append(
+			[]MethodParameter{
+				{
+					Class:      nil,
+					Classes:    selfClasses,
+					Name:       "я",
+					IsNullable: false,
+					IsVariadic: false,
+				},
+			},
+			parameters...,
+		),
+		[]MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: returnType == NilClass || returnType == ObjectClass,
+			},
+		},
+		func(ctx Context, args Tuple, _ StringDict) (Object, error) {
+			return f(ctx, args[0], args[1:])
+		},
+	)
+}
+
+func makeStringDict(methods []*Method) StringDict {
+	dict := StringDict{}
+	for _, method := range methods {
+		dict[method.Name] = method
+	}
+
+	return dict
+}
+
+// makeRingMethods creates methods, which add elements to the back and
+// take them from the front.
+func makeRingMethods(pkg *Package, selfClass *Class, addName, popName, emptyMessage string) []*Method {
+	selfClasses := []*Class{selfClass}
+	ringOf := func(self Object) *ring {
+		return self.(ringBased).base()
+	}
+
+	return []*Method{
+		newCollectionMethod(
+			pkg, addName, selfClasses, []MethodParameter{objectParameter("елемент")}, NilClass,
+			func(_ Context, self Object, args Tuple) (Object, error) {
+				ringOf(self).pushBack(args[0])
+				return Nil, nil
+			},
+		),
+		newCollectionMethod(
+			pkg, popName, selfClasses, nil, ObjectClass,
+			func(_ Context, self Object, _ Tuple) (Object, error) {
+				r := ringOf(self)
+				if err := checkNotEmpty(r.size, emptyMessage); err != nil {
+					return nil, err
+				}
+
+				return r.popFront(), nil
+			},
+		),
+		newCollectionMethod(
+			pkg, "перший", selfClasses, nil, ObjectClass,
+			func(_ Context, self Object, _ Tuple) (Object, error) {
+				r := ringOf(self)
+				if err := checkNotEmpty(r.size, emptyMessage); err != nil {
+					return nil, err
+				}
+
+				return r.at(0), nil
+			},
+		),
+		newCollectionMethod(
+			pkg, "очистити", selfClasses, nil, NilClass,
+			func(_ Context, self Object, _ Tuple) (Object, error) {
+				ringOf(self).clear()
+				return Nil, nil
+			},
+		),
+	}
+}
+
+func MakeDequeClassMethods(pkg *Package) StringDict {
+	selfClasses := []*Class{DequeClass}
+	methods := append(
+		makeRingMethods(pkg, DequeClass, "додати_в_кінець", "вилучити_з_початку", "дек порожній"),
+		newCollectionMethod(
+			pkg, "додати_на_початок", selfClasses, []MethodParameter{objectParameter("елемент")}, NilClass,
+			func(_ Context, self Object, args Tuple) (Object, error) {
+				self.(*Deque).pushFront(args[0])
+				return Nil, nil
+			},
+		),
+		newCollectionMethod(
+			pkg, "вилучити_з_кінця", selfClasses, nil, ObjectClass,
+			func(_ Context, self Object, _ Tuple) (Object, error) {
+				deque := self.(*Deque)
+				if err := checkNotEmpty(deque.size, "дек порожній"); err != nil {
+					return nil, err
+				}
+
+				return deque.popBack(), nil
+			},
+		),
+		newCollectionMethod(
+			pkg, "останній", selfClasses, nil, ObjectClass,
+			func(_ Context, self Object, _ Tuple) (Object, error) {
+				deque := self.(*Deque)
+				if err := checkNotEmpty(deque.size, "дек порожній"); err != nil {
+					return nil, err
+				}
+
+				return deque.at(deque.size - 1), nil
+			},
+		),
+		newCollectionMethod(
+			pkg, "розширити", selfClasses, []MethodParameter{objectParameter("ітерований")}, NilClass,
+			func(ctx Context, self Object, args Tuple) (Object, error) {
+				// Elements are collected first, so the deque can be
+				// extended with itself.
+				var elements []Object
+				err := IterateOver(
+					ctx, args[0], func(element Object) (bool, error) {
+						elements = append(elements, element)
+						return false, nil
+					},
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, element := range elements {
+					self.(*Deque).pushBack(element)
+				}
+
+				return Nil, nil
+			},
+		),
+		newCollectionMethod(
+			pkg, "обертати", selfClasses, []MethodParameter{intParameter("кількість")}, NilClass,
+			func(ctx Context, self Object, args Tuple) (Object, error) {
+				n, err := ToGoInt(ctx, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				self.(*Deque).Rotate(n)
+				return Nil, nil
+			},
+		),
+	)
+
+	return makeStringDict(methods)
+}
+
+func MakeQueueClassMethods(pkg *Package) StringDict {
+	return makeStringDict(makeRingMethods(pkg, QueueClass, "додати", "вилучити", "черга порожня"))
+}
+
+func MakePriorityQueueClassMethods(pkg *Package) StringDict {
+	selfClasses := []*Class{PriorityQueueClass}
+	return makeStringDict(
+		[]*Method{
+			newCollectionMethod(
+				pkg, "додати", selfClasses, []MethodParameter{objectParameter("елемент")}, NilClass,
+				func(ctx Context, self Object, args Tuple) (Object, error) {
+					if err := self.(*PriorityQueue).Push(ctx, args[0]); err != nil {
+						return nil, err
+					}
+
+					return Nil, nil
+				},
+			),
+			newCollectionMethod(
+				pkg, "вилучити", selfClasses, nil, ObjectClass,
+				func(ctx Context, self Object, _ Tuple) (Object, error) {
+					return self.(*PriorityQueue).Pop(ctx)
+				},
+			),
+			newCollectionMethod(
+				pkg, "перший", selfClasses, nil, ObjectClass,
+				func(_ Context, self Object, _ Tuple) (Object, error) {
+					return self.(*PriorityQueue).Peek()
+				},
+			),
+			newCollectionMethod(
+				pkg, "очистити", selfClasses, nil, NilClass,
+				func(_ Context, self Object, _ Tuple) (Object, error) {
+					self.(*PriorityQueue).Clear()
+					return Nil, nil
+				},
+			),
+		},
+	)
+}
+
+// makeMappingMethods creates methods shared by mappings based on the
+// dictionary. The default value is not created or counted by them.
+func makeMappingMethods(pkg *Package, selfClass *Class) []*Method {
+	selfClasses := []*Class{selfClass}
+	return []*Method{
+		newCollectionMethod(
+			pkg, "ключі", selfClasses, nil, ListClass,
+			func(_ Context, self Object, _ Tuple) (Object, error) {
+				list := NewList()
+				list.Values = self.(dictionaryBased).dictionary().Keys()
+				return list, nil
+			},
+		),
+		newCollectionMethod(
+			pkg, "значення", selfClasses, nil, ListClass,
+			func(_ Context, self Object, _ Tuple) (Object, error) {
+				list := NewList()
+				for _, entry := range self.(dictionaryBased).dictionary().entries {
+					list.Values = append(list.Values, entry.value)
+				}
+
+				return list, nil
+			},
+		),
+		newCollectionMethod(
+			pkg, "пари", selfClasses, nil, ListClass,
+			func(_ Context, self Object, _ Tuple) (Object, error) {
+				list := NewList()
+				for _, entry := range self.(dictionaryBased).dictionary().entries {
+					list.Values = append(list.Values, &Tuple{entry.key, entry.value})
+				}
+
+				return list, nil
+			},
+		),
+		newCollectionMethod(
+			pkg,
+			"отримати",
+			selfClasses,
+			[]MethodParameter{objectParameter("ключ"), objectParameter("за_замовчуванням")},
+			ObjectClass,
+			func(ctx Context, self Object, args Tuple) (Object, error) {
+				dict := self.(dictionaryBased).dictionary()
+				_, index, err := dict.find(ctx, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				if index == -1 {
+					return args[1], nil
+				}
+
+				return dict.entries[index].value, nil
+			},
+		),
+		newCollectionMethod(
+			pkg, "вилучити", selfClasses, []MethodParameter{objectParameter("ключ")}, ObjectClass,
+			func(ctx Context, self Object, args Tuple) (Object, error) {
+				return self.(dictionaryBased).dictionary().DeleteItem(ctx, args[0])
+			},
+		),
+		newCollectionMethod(
+			pkg, "очистити", selfClasses, nil, NilClass,
+			func(_ Context, self Object, _ Tuple) (Object, error) {
+				dict := self.(dictionaryBased).dictionary()
+				dict.entries, dict.buckets = nil, map[Int][]int{}
+				return Nil, nil
+			},
+		),
+	}
+}
+
+func MakeOrderedDictClassMethods(pkg *Package) StringDict {
+	selfClasses := []*Class{OrderedDictClass}
+	newPopMethod := func(name string, last bool) *Method {
+		return newCollectionMethod(
+			pkg, name, selfClasses, nil, TupleClass,
+			func(ctx Context, self Object, _ Tuple) (Object, error) {
+				return self.(*OrderedDict).PopItem(ctx, last)
+			},
+		)
+	}
+
+	newMoveMethod := func(name string, last bool) *Method {
+		return newCollectionMethod(
+			pkg, name, selfClasses, []MethodParameter{objectParameter("ключ")}, NilClass,
+			func(ctx Context, self Object, args Tuple) (Object, error) {
+				if err := self.(*OrderedDict).MoveToEnd(ctx, args[0], last); err != nil {
+					return nil, err
+				}
+
+				return Nil, nil
+			},
+		)
+	}
+
+	return makeStringDict(
+		append(
+			makeMappingMethods(pkg, OrderedDictClass),
+			newPopMethod("вилучити_перший", false),
+			newPopMethod("вилучити_останній", true),
+			newMoveMethod("перемістити_на_початок", false),
+			newMoveMethod("перемістити_в_кінець", true),
+		),
+	)
+}
+
+func MakeDefaultDictClassMethods(pkg *Package) StringDict {
+	return makeStringDict(
+		append(
+			makeMappingMethods(pkg, DefaultDictClass),
+			newCollectionMethod(
+				pkg, "фабрика", []*Class{DefaultDictClass}, nil, ObjectClass,
+				func(_ Context, self Object, _ Tuple) (Object, error) {
+					return self.(*DefaultDict).factory, nil
+				},
+			),
+		),
+	)
+}
+
+func MakeCounterClassMethods(pkg *Package) StringDict {
+	selfClasses := []*Class{CounterClass}
+	newUpdateMethod := func(name string, op func(ctx Context, a, b Object) (Object, error)) *Method {
+		return newCollectionMethod(
+			pkg, name, selfClasses, []MethodParameter{objectParameter("ітерований")}, NilClass,
+			func(ctx Context, self Object, args Tuple) (Object, error) {
+				if err := self.(*Counter).Update(ctx, args[0], op); err != nil {
+					return nil, err
+				}
+
+				return Nil, nil
+			},
+		)
+	}
+
+	return makeStringDict(
+		append(
+			makeMappingMethods(pkg, CounterClass),
+			newCollectionMethod(
+				pkg, "додати", selfClasses, []MethodParameter{objectParameter("елемент")}, NilClass,
+				func(ctx Context, self Object, args Tuple) (Object, error) {
+					if err := self.(*Counter).Increase(ctx, args[0], Int(1), Add); err != nil {
+						return nil, err
+					}
+
+					return Nil, nil
+				},
+			),
+			newUpdateMethod("оновити", Add),
+			newUpdateMethod("відняти", Sub),
+			newCollectionMethod(
+				pkg,
+				"найчастіші",
+				selfClasses,
+				[]MethodParameter{
+					{
+						Class:      IntClass,
+						Classes:    nil,
+						Name:       "кількість",
+						IsNullable: true,
+						IsVariadic: false,
+					},
+				},
+				ListClass,
+				func(ctx Context, self Object, args Tuple) (Object, error) {
+					n := -1
+					if args[0] != Nil {
+						var err error
+						if n, err = ToGoInt(ctx, args[0]); err != nil {
+							return nil, err
+						}
+
+						if n < 0 {
+							return nil, NewValueErrorf("кількість не може бути від'ємною, отримано %d", n)
+						}
+					}
+
+					pairs, err := self.(*Counter).MostCommon(ctx, n)
+					if err != nil {
+						return nil, err
+					}
+
+					list := NewList()
+					list.Values = pairs
+					return list, nil
+				},
+			),
+			newCollectionMethod(
+				pkg, "елементи_з_повтореннями", selfClasses, nil, ListClass,
+				func(ctx Context, self Object, _ Tuple) (Object, error) {
+					elements, err := self.(*Counter).Elements(ctx)
+					if err != nil {
+						return nil, err
+					}
+
+					list := NewList()
+					list.Values = elements
+					return list, nil
+				},
+			),
+			newCollectionMethod(
+				pkg, "всього", selfClasses, nil, ObjectClass,
+				func(ctx Context, self Object, _ Tuple) (Object, error) {
+					return self.(*Counter).Total(ctx)
+				},
+			),
+		),
+	)
+}
@@ -0,0 +1,392 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"strconv"
+	"strings"
+)
+
+var ComplexClass = ObjectClass.ClassNew("комплексне", map[string]Object{}, true, ComplexNew, nil)
+
+// Complex is a complex number with real and imaginary parts of type
+// 'дійсне'. Imaginary literals are written with the 'і' suffix, i.e.
+// 2і or 1.5і, and operations with other numbers give complex numbers.
+type Complex complex128
+
+func (value Complex) Class() *Class {
+	return ComplexClass
+}
+
+func ComplexNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	switch len(args) {
+	case 0:
+		return Complex(0), nil
+	case 1:
+		if str, ok := args[0].(String); ok {
+			return ComplexFromString(string(str))
+		}
+
+		return toComplex(args[0], "дійсна частина")
+	case 2:
+		realPart, err := toComplex(args[0], "дійсна частина")
+		if err != nil {
+			return nil, err
+		}
+
+		imagPart, err := toComplex(args[1], "уявна частина")
+		if err != nil {
+			return nil, err
+		}
+
+		return realPart + imagPart*1i, nil
+	default:
+		return nil, NewTypeErrorf("%s() приймає не більше 2 аргументів (отримано %d)", cls.Name, len(args))
+	}
+}
+
+// ComplexFromString parses complex numbers like "1+2і", "(1-2і)", "2.5і"
+// and "3", the Latin 'i' and 'j' can be used instead of 'і'.
+func ComplexFromString(str string) (Object, error) {
+	value := strings.TrimSpace(str)
+	value = strings.NewReplacer("і", "i", "j", "i").Replace(value)
+	c, err := strconv.ParseComplex(value, 128)
+	if err != nil {
+		if numErr, ok := err.(*strconv.NumError); !ok || numErr.Err != strconv.ErrRange {
+			return nil, NewValueErrorf("некоректний літерал для 'комплексне()': '%s'", str)
+		}
+	}
+
+	return Complex(c), nil
+}
+
+// ImaginaryFromString creates a complex number from the imaginary
+// literal without the suffix.
+func ImaginaryFromString(str string) (Object, error) {
+	f, err := RealFromString(str)
+	if err != nil {
+		return nil, err
+	}
+
+	return Complex(complex(0, float64(f.(Real)))), nil
+}
+
+// complexOperand converts a number to complex128. ok is false if the
+// object is not a number.
+func complexOperand(other Object) (result complex128, ok bool, err error) {
+	switch other := other.(type) {
+	case Complex:
+		return complex128(other), true, nil
+	case Real:
+		return complex(float64(other), 0), true, nil
+	case Int:
+		return complex(float64(other), 0), true, nil
+	case Bool:
+		return complex(float64(bo2ro(other)), 0), true, nil
+	case *BigInt:
+		f, err := bigIntToReal(other.Big())
+		return complex(float64(f), 0), true, err
+	case *Fraction:
+		f, err := ratToReal(other.Rat())
+		return complex(float64(f), 0), true, err
+	case *Decimal:
+		f, err := ratToReal(other.rat())
+		return complex(float64(f), 0), true, err
+	}
+
+	return 0, false, nil
+}
+
+func toComplex(value Object, name string) (Complex, error) {
+	c, ok, err := complexOperand(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if !ok {
+		return 0, NewTypeErrorf("%s комплексного числа має бути числом, отримано '%s'", name, value.Class().Name)
+	}
+
+	return Complex(c), nil
+}
+
+func complexDiv(a, b complex128) (Object, error) {
+	if b == 0 {
+		return nil, NewZeroDivisionError("ділення на нуль")
+	}
+
+	return Complex(a / b), nil
+}
+
+// complexPow raises the complex number to the power. Small integer
+// exponents use repeated multiplication, so the results like
+// (1+2і) ** 2 are exact.
+func complexPow(a, b complex128) (Object, error) {
+	if b == 0 {
+		return Complex(1), nil
+	}
+
+	if a == 0 {
+		if real(b) < 0 || imag(b) != 0 {
+			return nil, NewZeroDivisionError("неможливо піднести 0 до від'ємного або комплексного степеня")
+		}
+
+		return Complex(0), nil
+	}
+
+	if n := real(b); imag(b) == 0 && n == math.Trunc(n) && math.Abs(n) <= 100 {
+		result := complex128(1)
+		base := a
+		for exponent := int(math.Abs(n)); exponent > 0; exponent >>= 1 {
+			if exponent&1 == 1 {
+				result *= base
+			}
+
+			base *= base
+		}
+
+		if n < 0 {
+			return complexDiv(1, result)
+		}
+
+		return Complex(result), nil
+	}
+
+	return Complex(cmplx.Pow(a, b)), nil
+}
+
+// operation performs the binary operation with the numeric operand,
+// the value is the right operand if 'reversed' is true. Other operands
+// are not supported, so (nil, nil) is returned.
+func (value Complex) operation(
+	other Object,
+	reversed bool,
+	op func(a, b complex128) (Object, error),
+) (Object, error) {
+	otherValue, ok, err := complexOperand(other)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	if reversed {
+		return op(otherValue, complex128(value))
+	}
+
+	return op(complex128(value), otherValue)
+}
+
+func formatComplexPart(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func (value Complex) represent(ctx Context) (Object, error) {
+	return value.string(ctx)
+}
+
+func (value Complex) string(Context) (Object, error) {
+	re, im := real(value), imag(value)
+	imagPart := formatComplexPart(im) + "і"
+	if re == 0 && !math.Signbit(re) {
+		return String(imagPart), nil
+	}
+
+	if !math.Signbit(im) || math.IsNaN(im) {
+		imagPart = "+" + imagPart
+	}
+
+	return String(fmt.Sprintf("(%s%s)", formatComplexPart(re), imagPart)), nil
+}
+
+func (value Complex) toBool(Context) (Object, error) {
+	return gb2bo(value != 0), nil
+}
+
+func (value Complex) hash(ctx Context) (Object, error) {
+	// Equal numbers must have equal hashes, i.e. 1+0і and 1.
+	realHash, err := Real(real(value)).hash(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	imagHash, err := Real(imag(value)).hash(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return realHash.(Int) + 1000003*imagHash.(Int), nil
+}
+
+func (value Complex) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+func (value Complex) add(_ Context, other Object) (Object, error) {
+	return value.operation(
+		other, false, func(a, b complex128) (Object, error) {
+			return Complex(a + b), nil
+		},
+	)
+}
+
+func (value Complex) reversedAdd(_ Context, other Object) (Object, error) {
+	return value.operation(
+		other, true, func(a, b complex128) (Object, error) {
+			return Complex(a + b), nil
+		},
+	)
+}
+
+func (value Complex) sub(_ Context, other Object) (Object, error) {
+	return value.operation(
+		other, false, func(a, b complex128) (Object, error) {
+			return Complex(a - b), nil
+		},
+	)
+}
+
+func (value Complex) reversedSub(_ Context, other Object) (Object, error) {
+	return value.operation(
+		other, true, func(a, b complex128) (Object, error) {
+			return Complex(a - b), nil
+		},
+	)
+}
+
+func (value Complex) mul(_ Context, other Object) (Object, error) {
+	return value.operation(
+		other, false, func(a, b complex128) (Object, error) {
+			return Complex(a * b), nil
+		},
+	)
+}
+
+func (value Complex) reversedMul(_ Context, other Object) (Object, error) {
+	return value.operation(
+		other, true, func(a, b complex128) (Object, error) {
+			return Complex(a * b), nil
+		},
+	)
+}
+
+func (value Complex) div(_ Context, other Object) (Object, error) {
+	return value.operation(other, false, complexDiv)
+}
+
+func (value Complex) reversedDiv(_ Context, other Object) (Object, error) {
+	return value.operation(other, true, complexDiv)
+}
+
+func (value Complex) pow(_ Context, other Object) (Object, error) {
+	return value.operation(other, false, complexPow)
+}
+
+func (value Complex) reversedPow(_ Context, other Object) (Object, error) {
+	return value.operation(other, true, complexPow)
+}
+
+func (value Complex) equals(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(Complex); ok {
+		return gb2bo(value == otherValue), nil
+	}
+
+	if _, ok, _ := complexOperand(other); !ok {
+		return nil, nil
+	}
+
+	if imag(value) != 0 {
+		return False, nil
+	}
+
+	// Compare the real part with the other number without loss of
+	// precision, i.e. with big integers and fractions.
+	return Equals(ctx, Real(real(value)), other)
+}
+
+func (value Complex) notEquals(ctx Context, other Object) (Object, error) {
+	result, err := value.equals(ctx, other)
+	if err != nil || result == nil {
+		return nil, err
+	}
+
+	return !result.(Bool), nil
+}
+
+func (value Complex) positive(_ Context) (Object, error) {
+	return value, nil
+}
+
+func (value Complex) negate(_ Context) (Object, error) {
+	return -value, nil
+}
+
+func newComplexMethod(
+	pkg *Package,
+	name string,
+	returnType *Class,
+	f func(self Complex) Object,
+) *Method {
+	return MethodNew(
+		name,
+		pkg,
+		[]MethodParameter{
+			{
+				Class:      ComplexClass,
+				Classes:    nil,
+				Name:       "я",
+				IsNullable: false,
+				IsVariadic: false,
+			},
+		},
+		[]MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: false,
+			},
+		},
+		func(_ Context, args Tuple, _ StringDict) (Object, error) {
+			return f(args[0].(Complex)), nil
+		},
+	)
+}
+
+func MakeComplexClassMethods(pkg *Package) StringDict {
+	methods := []*Method{
+		newComplexMethod(
+			pkg, "дійсна", RealClass, func(self Complex) Object {
+				return Real(real(self))
+			},
+		),
+		newComplexMethod(
+			pkg, "уявна", RealClass, func(self Complex) Object {
+				return Real(imag(self))
+			},
+		),
+		newComplexMethod(
+			pkg, "модуль", RealClass, func(self Complex) Object {
+				return Real(cmplx.Abs(complex128(self)))
+			},
+		),
+		newComplexMethod(
+			pkg, "фаза", RealClass, func(self Complex) Object {
+				return Real(cmplx.Phase(complex128(self)))
+			},
+		),
+		newComplexMethod(
+			pkg, "спряжене", ComplexClass, func(self Complex) Object {
+				return Complex(cmplx.Conj(complex128(self)))
+			},
+		),
+	}
+
+	dict := StringDict{}
+	for _, method := range methods {
+		dict[method.Name] = method
+	}
+
+	return dict
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// Standard streams of the process. The standard output is buffered,
+// so it must be flushed by FlushStandardStreams before the exit. The
+// output streams are write-only, so they have no reader.
+var (
+	Stdin = &File{
+		file:     os.Stdin,
+		reader:   bufio.NewReader(os.Stdin),
+		mode:     "ч",
+		readable: true,
+	}
+	Stdout = &File{
+		file:     os.Stdout,
+		writer:   bufio.NewWriter(os.Stdout),
+		mode:     "з",
+		writable: true,
+	}
+	Stderr = &File{
+		file:     os.Stderr,
+		mode:     "з",
+		writable: true,
+	}
+)
+
+// FlushStandardStreams writes the buffered standard output.
+func FlushStandardStreams() error {
+	return Stdout.Flush()
+}
+
+// Print writes string values of objects to the file, separated by the
+// separator and followed by the ending.
+func Print(ctx Context, file *File, values Tuple, separator, ending string) error {
+	var builder strings.Builder
+	for i, value := range values {
+		if i != 0 {
+			builder.WriteString(separator)
+		}
+
+		text, err := ToGoString(ctx, value)
+		if err != nil {
+			return err
+		}
+
+		builder.WriteString(text)
+	}
+
+	builder.WriteString(ending)
+	_, err := file.Write(ctx, String(builder.String()))
+	return err
+}
+
+// Input writes the prompt to the standard output and reads a line from
+// the standard input without the line break. Nil is returned if the
+// end of the input is reached before any character is read.
+func Input(ctx Context, prompt string) (Object, error) {
+	if _, err := Stdout.Write(ctx, String(prompt)); err != nil {
+		return nil, err
+	}
+
+	data, err := Stdin.readLine()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return Nil, nil
+	}
+
+	line, err := Stdin.decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return String(strings.TrimSuffix(strings.TrimSuffix(string(line.(String)), "\n"), "\r")), nil
+}
@@ -0,0 +1,263 @@
+package types
+
+var CounterClass = ObjectClass.ClassNew("лічильник", map[string]Object{}, true, CounterNew, nil)
+
+// Counter is a multiset, which maps elements to numbers of their
+// occurrences. The number of the absent element is 0.
+type Counter struct {
+	dict *Dictionary
+}
+
+func NewCounter() *Counter {
+	return &Counter{dict: NewDictionary()}
+}
+
+// CounterNew creates the counter with elements of the optional iterable
+// or numbers of the optional dictionary.
+func CounterNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	counter := NewCounter()
+	switch len(args) {
+	case 0:
+		return counter, nil
+	case 1:
+		if err := counter.Update(ctx, args[0], Add); err != nil {
+			return nil, err
+		}
+
+		return counter, nil
+	default:
+		return nil, NewTypeErrorf("%s() приймає не більше 1 аргументу (отримано %d)", cls.Name, len(args))
+	}
+}
+
+func (value *Counter) Class() *Class {
+	return CounterClass
+}
+
+func (value *Counter) dictionary() *Dictionary {
+	return value.dict
+}
+
+// count returns the number of the element, 0 if it is absent.
+func (value *Counter) count(ctx Context, element Object) (Object, error) {
+	_, index, err := value.dict.find(ctx, element)
+	if err != nil {
+		return nil, err
+	}
+
+	if index == -1 {
+		return Int(0), nil
+	}
+
+	return value.dict.entries[index].value, nil
+}
+
+// Increase combines the number of the element with n using op.
+func (value *Counter) Increase(
+	ctx Context, element, n Object, op func(ctx Context, a, b Object) (Object, error),
+) error {
+	count, err := value.count(ctx, element)
+	if err != nil {
+		return err
+	}
+
+	if count, err = op(ctx, count, n); err != nil {
+		return err
+	}
+
+	_, err = value.dict.SetItem(ctx, element, count)
+	return err
+}
+
+// Update combines numbers with numbers of the dictionary, or with 1 for
+// each element of the iterable, using op.
+func (value *Counter) Update(ctx Context, arg Object, op func(ctx Context, a, b Object) (Object, error)) error {
+	if other, ok := asDictionary(arg); ok {
+		// The counter may be updated with itself, so the entries are
+		// copied before the numbers change.
+		for _, entry := range append([]dictionaryEntry{}, other.entries...) {
+			if err := value.Increase(ctx, entry.key, entry.value, op); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return IterateOver(
+		ctx, arg, func(element Object) (bool, error) {
+			return false, value.Increase(ctx, element, Int(1), op)
+		},
+	)
+}
+
+// MostCommon returns tuples of elements and their numbers from the
+// largest number, elements with equal numbers are in the insertion
+// order. Negative n means all elements.
+func (value *Counter) MostCommon(ctx Context, n int) ([]Object, error) {
+	pairs := make([]Object, len(value.dict.entries))
+	for i, entry := range value.dict.entries {
+		pairs[i] = &Tuple{entry.key, entry.value}
+	}
+
+	err := sortObjects(
+		pairs, func(a, b Object) (bool, error) {
+			return goBool(ctx, Less, (*b.(*Tuple))[1], (*a.(*Tuple))[1])
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if n >= 0 && n < len(pairs) {
+		pairs = pairs[:n]
+	}
+
+	return pairs, nil
+}
+
+// Elements returns each element repeated as many times as its number,
+// elements with non-positive numbers are omitted.
+func (value *Counter) Elements(ctx Context) ([]Object, error) {
+	var elements []Object
+	for _, entry := range value.dict.entries {
+		n, err := ToGoInt(ctx, entry.value)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := 0; i < n; i++ {
+			elements = append(elements, entry.key)
+		}
+	}
+
+	return elements, nil
+}
+
+// Total returns the sum of all numbers.
+func (value *Counter) Total(ctx Context) (Object, error) {
+	var total Object = Int(0)
+	for _, entry := range value.dict.entries {
+		var err error
+		if total, err = Add(ctx, total, entry.value); err != nil {
+			return nil, err
+		}
+	}
+
+	return total, nil
+}
+
+func (value *Counter) Length(ctx Context) (Int, error) {
+	return value.dict.Length(ctx)
+}
+
+func (value *Counter) GetItem(ctx Context, key Object) (Object, error) {
+	return value.count(ctx, key)
+}
+
+func (value *Counter) SetItem(ctx Context, key Object, item Object) (Object, error) {
+	if _, err := value.dict.SetItem(ctx, key, item); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *Counter) DeleteItem(ctx Context, key Object) (Object, error) {
+	return value.dict.DeleteItem(ctx, key)
+}
+
+func (value *Counter) represent(ctx Context) (Object, error) {
+	return representMapping(ctx, value, "")
+}
+
+func (value *Counter) string(ctx Context) (Object, error) {
+	return value.represent(ctx)
+}
+
+func (value *Counter) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+// combine creates the counter with numbers of both counters combined
+// by op, only positive results are kept.
+func (value *Counter) combine(
+	ctx Context, other Object, op func(ctx Context, a, b Object) (Object, error),
+) (Object, error) {
+	o, ok := other.(*Counter)
+	if !ok {
+		return nil, nil
+	}
+
+	combined := NewCounter()
+	if err := combined.Update(ctx, value, Add); err != nil {
+		return nil, err
+	}
+
+	if err := combined.Update(ctx, o, op); err != nil {
+		return nil, err
+	}
+
+	result := NewCounter()
+	for _, entry := range combined.dict.entries {
+		positive, err := goBool(ctx, Less, Int(0), entry.value)
+		if err != nil {
+			return nil, err
+		}
+
+		if positive {
+			if _, err := result.dict.SetItem(ctx, entry.key, entry.value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (value *Counter) add(ctx Context, other Object) (Object, error) {
+	return value.combine(ctx, other, Add)
+}
+
+func (value *Counter) sub(ctx Context, other Object) (Object, error) {
+	return value.combine(ctx, other, Sub)
+}
+
+func (value *Counter) equals(ctx Context, other Object) (Object, error) {
+	return value.dict.equals(ctx, other)
+}
+
+func (value *Counter) notEquals(ctx Context, other Object) (Object, error) {
+	result, err := value.equals(ctx, other)
+	if err != nil {
+		return nil, err
+	}
+
+	return !result.(Bool), nil
+}
+
+func (value *Counter) toBool(ctx Context) (Object, error) {
+	return value.dict.toBool(ctx)
+}
+
+func (value *Counter) contains(ctx Context, item Object) (Object, error) {
+	return value.dict.contains(ctx, item)
+}
+
+func (value *Counter) iterate(ctx Context) (Object, error) {
+	return value.dict.iterate(ctx)
+}
+
+func (value *Counter) copy(ctx Context) (Object, error) {
+	return CounterNew(ctx, CounterClass, Tuple{value})
+}
+
+func (value *Counter) deepCopy(ctx Context, memo map[Object]Object) (Object, error) {
+	counter := NewCounter()
+	memo[value] = counter
+	if err := deepCopyDictionary(ctx, counter.dict, value.dict, memo); err != nil {
+		return nil, err
+	}
+
+	return counter, nil
+}
@@ -0,0 +1,193 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+var DateTimeClass = ObjectClass.ClassNew("дата_час", map[string]Object{}, true, DateTimeNew, nil)
+
+// DateTime is a moment of time in a time zone with nanosecond
+// precision. Dates are compared as moments, regardless of their time
+// zones. Adding a duration gives a date, and the difference of two
+// dates is a duration.
+type DateTime time.Time
+
+// DateTimeNew creates the date in the local time zone from the year,
+// month and day followed by optional hour, minute, second and
+// nanosecond.
+func DateTimeNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	if len(args) < 3 || len(args) > 7 {
+		return nil, NewTypeErrorf("%s() приймає від 3 до 7 аргументів (отримано %d)", cls.Name, len(args))
+	}
+
+	var parts [7]int
+	for i, arg := range args {
+		switch arg.(type) {
+		case Int, Bool:
+		default:
+			return nil, NewTypeErrorf("частини дати мають бути цілими числами, отримано '%s'", arg.Class().Name)
+		}
+
+		var err error
+		if parts[i], err = ToGoInt(ctx, arg); err != nil {
+			return nil, err
+		}
+	}
+
+	t, err := NewTime(parts[0], parts[1], parts[2], parts[3], parts[4], parts[5], parts[6], time.Local)
+	if err != nil {
+		return nil, err
+	}
+
+	return DateTime(t), nil
+}
+
+// LoadLocation finds the time zone by its name in the tz database of
+// the system, i.e. "Europe/Kyiv" or "UTC". "Local" is the local time
+// zone.
+func LoadLocation(name string) (*time.Location, error) {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, NewValueErrorf("невідомий часовий пояс '%s'", name)
+	}
+
+	return location, nil
+}
+
+func (value DateTime) Class() *Class {
+	return DateTimeClass
+}
+
+func (value DateTime) Time() time.Time {
+	return time.Time(value)
+}
+
+func (value DateTime) represent(ctx Context) (Object, error) {
+	str, err := value.string(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return String(fmt.Sprintf("<%s %s>", DateTimeClass.Name, str)), nil
+}
+
+// string formats the date as "2026-10-19 14:03:05 +03:00", the fraction
+// of a second is added if it is not zero.
+func (value DateTime) string(Context) (Object, error) {
+	return String(value.Time().Format("2006-01-02 15:04:05.999999999 -07:00")), nil
+}
+
+func (value DateTime) hash(Context) (Object, error) {
+	t := value.Time()
+	return Int(t.Unix()*int64(time.Second) + int64(t.Nanosecond())), nil
+}
+
+func (value DateTime) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+func (value DateTime) addDuration(d Duration) (Object, error) {
+	result := value.Time().Add(time.Duration(d))
+	if result.Sub(value.Time()) != time.Duration(d) {
+		return nil, NewOverflowErrorf("дата поза допустимим діапазоном")
+	}
+
+	return DateTime(result), nil
+}
+
+func (value DateTime) add(_ Context, other Object) (Object, error) {
+	if d, ok := other.(Duration); ok {
+		return value.addDuration(d)
+	}
+
+	return nil, nil
+}
+
+// sub subtracts the duration giving a date, or the date giving
+// a duration.
+func (value DateTime) sub(_ Context, other Object) (Object, error) {
+	switch otherValue := other.(type) {
+	case Duration:
+		if otherValue == math.MinInt64 {
+			return nil, NewOverflowErrorf("дата поза допустимим діапазоном")
+		}
+
+		return value.addDuration(-otherValue)
+	case DateTime:
+		d := value.Time().Sub(otherValue.Time())
+		if !otherValue.Time().Add(d).Equal(value.Time()) {
+			return nil, NewOverflowErrorf("різниця дат занадто велика")
+		}
+
+		return Duration(d), nil
+	default:
+		return nil, nil
+	}
+}
+
+func dateTimeComparison(value DateTime, other Object, predicate func(result int) bool) (Object, error) {
+	otherValue, ok := other.(DateTime)
+	if !ok {
+		return nil, nil
+	}
+
+	result := 0
+	switch {
+	case value.Time().Before(otherValue.Time()):
+		result = -1
+	case value.Time().After(otherValue.Time()):
+		result = 1
+	}
+
+	return gb2bo(predicate(result)), nil
+}
+
+func (value DateTime) equals(_ Context, other Object) (Object, error) {
+	return dateTimeComparison(
+		value, other, func(result int) bool {
+			return result == 0
+		},
+	)
+}
+
+func (value DateTime) notEquals(_ Context, other Object) (Object, error) {
+	return dateTimeComparison(
+		value, other, func(result int) bool {
+			return result != 0
+		},
+	)
+}
+
+func (value DateTime) less(_ Context, other Object) (Object, error) {
+	return dateTimeComparison(
+		value, other, func(result int) bool {
+			return result < 0
+		},
+	)
+}
+
+func (value DateTime) lessOrEquals(_ Context, other Object) (Object, error) {
+	return dateTimeComparison(
+		value, other, func(result int) bool {
+			return result <= 0
+		},
+	)
+}
+
+func (value DateTime) greater(_ Context, other Object) (Object, error) {
+	return dateTimeComparison(
+		value, other, func(result int) bool {
+			return result > 0
+		},
+	)
+}
+
+func (value DateTime) greaterOrEquals(_ Context, other Object) (Object, error) {
+	return dateTimeComparison(
+		value, other, func(result int) bool {
+			return result >= 0
+		},
+	)
+}
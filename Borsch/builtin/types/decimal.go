@@ -0,0 +1,717 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+var DecimalClass = ObjectClass.ClassNew("десяткове", map[string]Object{}, true, DecimalNew, nil)
+
+// defaultDecimalPrecision is the initial number of significant digits
+// of results which can not be represented exactly, e.g. of 1 / 3.
+const defaultDecimalPrecision = 28
+
+// maxDecimalPrecision bounds the number of significant digits, so
+// inexact results stay reasonably small.
+const maxDecimalPrecision = 10000
+
+// decimalPrecision is the current number of significant digits of
+// inexact results. It is changed by SetDecimalPrecision.
+var decimalPrecision int64 = defaultDecimalPrecision
+
+// DecimalPrecision returns the number of significant digits of results
+// of decimal arithmetic which can not be represented exactly.
+func DecimalPrecision() int {
+	return int(atomic.LoadInt64(&decimalPrecision))
+}
+
+// SetDecimalPrecision sets the number of significant digits of results
+// of decimal arithmetic which can not be represented exactly.
+//
+// Will raise ValueError if the precision is out of [1, 10000].
+func SetDecimalPrecision(precision int) error {
+	if precision < 1 || precision > maxDecimalPrecision {
+		return NewValueErrorf("точність має бути від 1 до %d, отримано %d", maxDecimalPrecision, precision)
+	}
+
+	atomic.StoreInt64(&decimalPrecision, int64(precision))
+	return nil
+}
+
+// maxDecimalExponent bounds the exponent of decimal numbers, so their
+// digits and the powers of ten used in arithmetic stay reasonably
+// small.
+const maxDecimalExponent = 999999
+
+// maxExactAlignment is the largest difference of exponents for which
+// sums are computed exactly.
+const maxExactAlignment = 1000
+
+// roundingMode defines how a decimal number is rounded when digits
+// are discarded.
+type roundingMode string
+
+const (
+	roundUp       roundingMode = "вгору"
+	roundDown     roundingMode = "вниз"
+	roundCeiling  roundingMode = "до_стелі"
+	roundFloor    roundingMode = "до_підлоги"
+	roundHalfUp   roundingMode = "половина_вгору"
+	roundHalfDown roundingMode = "половина_вниз"
+	roundHalfEven roundingMode = "половина_до_парного"
+)
+
+var roundingModes = []roundingMode{
+	roundUp, roundDown, roundCeiling, roundFloor, roundHalfUp, roundHalfDown, roundHalfEven,
+}
+
+func parseRoundingMode(name string) (roundingMode, error) {
+	names := make([]string, len(roundingModes))
+	for i, mode := range roundingModes {
+		if string(mode) == name {
+			return mode, nil
+		}
+
+		names[i] = fmt.Sprintf("'%s'", mode)
+	}
+
+	return "", NewValueErrorf(
+		"невідомий режим округлення '%s', очікується один із %s", name, strings.Join(names, ", "),
+	)
+}
+
+// roundQuotient rounds n / d to an integer using the rounding mode.
+func roundQuotient(n, d *big.Int, mode roundingMode) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(n, d, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	negative := (n.Sign() < 0) != (d.Sign() < 0)
+	half := new(big.Int).Lsh(new(big.Int).Abs(remainder), 1).CmpAbs(d)
+	increment := false
+	switch mode {
+	case roundUp:
+		increment = true
+	case roundCeiling:
+		increment = !negative
+	case roundFloor:
+		increment = negative
+	case roundHalfUp:
+		increment = half >= 0
+	case roundHalfDown:
+		increment = half > 0
+	case roundHalfEven:
+		increment = half > 0 || half == 0 && quotient.Bit(0) == 1
+	}
+
+	if increment {
+		if negative {
+			return quotient.Sub(quotient, big.NewInt(1))
+		}
+
+		return quotient.Add(quotient, big.NewInt(1))
+	}
+
+	return quotient
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// checkExponent returns the error if the exponent of a result is out
+// of range.
+func checkExponent(result *Decimal) (Object, error) {
+	if result.exponent > maxDecimalExponent || result.exponent < -maxDecimalExponent {
+		return nil, NewOverflowErrorf("показник десяткового числа виходить за межі ±%d", maxDecimalExponent)
+	}
+
+	return result, nil
+}
+
+func countDigits(x *big.Int) int {
+	if x.Sign() == 0 {
+		return 1
+	}
+
+	return len(new(big.Int).Abs(x).String())
+}
+
+// Decimal is an exact decimal number coefficient * 10 ** exponent.
+// Decimals are immutable, the exponent keeps the number of digits after
+// the decimal point, so "1.50" and "1.5" are equal but are converted
+// to string differently.
+type Decimal struct {
+	coefficient *big.Int
+	exponent    int
+}
+
+func NewDecimal(coefficient *big.Int, exponent int) *Decimal {
+	return &Decimal{coefficient: coefficient, exponent: exponent}
+}
+
+func (value *Decimal) Class() *Class {
+	return DecimalClass
+}
+
+func DecimalNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	switch len(args) {
+	case 0:
+		return NewDecimal(new(big.Int), 0), nil
+	case 1:
+		return toDecimal(args[0])
+	default:
+		return nil, NewTypeErrorf("%s() приймає не більше 1 аргументу (отримано %d)", cls.Name, len(args))
+	}
+}
+
+// DecimalFromString parses decimals like "12", "-0.50" and "1.5e-3".
+func DecimalFromString(str string) (Object, error) {
+	s := strings.TrimSpace(str)
+	exponent := 0
+	if i := strings.IndexAny(s, "eE"); i != -1 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return nil, NewValueErrorf("некоректний літерал для 'десяткове()': '%s'", str)
+		}
+
+		exponent, s = e, s[:i]
+	}
+
+	sign := ""
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		sign, s = s[:1], s[1:]
+	}
+
+	integer, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		integer, fraction = s[:i], s[i+1:]
+	}
+
+	digits := integer + fraction
+	if digits == "" || strings.TrimLeft(digits, "0123456789") != "" {
+		return nil, NewValueErrorf("некоректний літерал для 'десяткове()': '%s'", str)
+	}
+
+	exponent -= len(fraction)
+	if exponent > maxDecimalExponent || exponent < -maxDecimalExponent {
+		return nil, NewValueErrorf(
+			"показник десяткового числа '%s' виходить за межі ±%d", str, maxDecimalExponent,
+		)
+	}
+
+	coefficient, _ := new(big.Int).SetString(sign+digits, 10)
+	return NewDecimal(coefficient, exponent), nil
+}
+
+// decimalFromRat rounds the rational number to the given exponent.
+func decimalFromRat(x *big.Rat, exponent int, mode roundingMode) *Decimal {
+	numerator := new(big.Int).Set(x.Num())
+	denominator := new(big.Int).Set(x.Denom())
+	if exponent < 0 {
+		numerator.Mul(numerator, pow10(-exponent))
+	} else {
+		denominator.Mul(denominator, pow10(exponent))
+	}
+
+	return NewDecimal(roundQuotient(numerator, denominator, mode), exponent)
+}
+
+// decimalWithPrecision rounds the rational number to DecimalPrecision
+// significant digits. If the number is represented exactly, trailing
+// zeros are removed until the exponent reaches the ideal exponent.
+func decimalWithPrecision(x *big.Rat, idealExponent int) *Decimal {
+	if x.Sign() == 0 {
+		return NewDecimal(new(big.Int), idealExponent)
+	}
+
+	// Find the exponent for which the integer part has exactly
+	// precision digits.
+	precision := DecimalPrecision()
+	abs := new(big.Rat).Abs(x)
+	exponent := countDigits(abs.Num()) - countDigits(abs.Denom()) - precision
+	for {
+		digits := countDigits(decimalFromRat(abs, exponent, roundDown).coefficient)
+		if digits == precision {
+			break
+		}
+
+		exponent += digits - precision
+	}
+
+	result := decimalFromRat(x, exponent, roundHalfEven)
+	if countDigits(result.coefficient) > precision {
+		result = NewDecimal(result.coefficient.Quo(result.coefficient, big.NewInt(10)), result.exponent+1)
+	}
+
+	if result.rat().Cmp(x) == 0 {
+		ten := big.NewInt(10)
+		for result.exponent < idealExponent {
+			quotient, remainder := new(big.Int).QuoRem(result.coefficient, ten, new(big.Int))
+			if remainder.Sign() != 0 {
+				break
+			}
+
+			result = NewDecimal(quotient, result.exponent+1)
+		}
+	}
+
+	return result
+}
+
+// decimalOperand converts an exact operand, i.e. Int, BigInt, Bool
+// or Decimal, to Decimal.
+func decimalOperand(other Object) (*Decimal, bool) {
+	if otherValue, ok := other.(*Decimal); ok {
+		return otherValue, true
+	}
+
+	if x, ok := bigIntOperand(other); ok {
+		return NewDecimal(x, 0), true
+	}
+
+	return nil, false
+}
+
+// toDecimal converts a number or a string to Decimal.
+func toDecimal(value Object) (Object, error) {
+	switch value := value.(type) {
+	case String:
+		return DecimalFromString(string(value))
+	case Real:
+		f := float64(value)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, NewValueErrorf("неможливо перетворити %g у десяткове число", f)
+		}
+
+		// The shortest representation is used, so 0.1 is converted
+		// to 0.1 instead of its exact binary value.
+		return DecimalFromString(strconv.FormatFloat(f, 'g', -1, 64))
+	case *Fraction:
+		return decimalWithPrecision(value.Rat(), 0), nil
+	}
+
+	if x, ok := decimalOperand(value); ok {
+		return x, nil
+	}
+
+	return nil, NewTypeErrorf("неможливо перетворити об'єкт типу '%s' у десяткове число", value.Class().Name)
+}
+
+func (value *Decimal) rat() *big.Rat {
+	if value.exponent >= 0 {
+		return new(big.Rat).SetInt(new(big.Int).Mul(value.coefficient, pow10(value.exponent)))
+	}
+
+	return new(big.Rat).SetFrac(value.coefficient, pow10(-value.exponent))
+}
+
+// alignDecimals returns coefficients of both numbers with the same exponent.
+func alignDecimals(a, b *Decimal) (*big.Int, *big.Int, int) {
+	switch {
+	case a.exponent > b.exponent:
+		scaled := new(big.Int).Mul(a.coefficient, pow10(a.exponent-b.exponent))
+		return scaled, b.coefficient, b.exponent
+	case a.exponent < b.exponent:
+		scaled := new(big.Int).Mul(b.coefficient, pow10(b.exponent-a.exponent))
+		return a.coefficient, scaled, a.exponent
+	default:
+		return a.coefficient, b.coefficient, a.exponent
+	}
+}
+
+// adjustedExponent returns the exponent of the most significant digit.
+func (value *Decimal) adjustedExponent() int {
+	return value.exponent + countDigits(value.coefficient) - 1
+}
+
+// dropNegligible replaces the operand of the sum, which is too small
+// to affect significant digits of the other one, with a single digit
+// just below them if exponents differ by more than maxExactAlignment,
+// so the sum does not need 10 ** n for the whole difference. The
+// returned precision is zero if the sum is exact, otherwise the sum
+// must be rounded to it.
+func dropNegligible(a, b *Decimal) (*Decimal, *Decimal, int) {
+	large, small := a, b
+	if large.exponent < small.exponent {
+		large, small = small, large
+	}
+
+	if large.exponent-small.exponent <= maxExactAlignment {
+		return a, b, 0
+	}
+
+	// The zero does not change the other operand, so it takes the
+	// exponent of that operand.
+	if a.coefficient.Sign() == 0 {
+		return NewDecimal(a.coefficient, b.exponent), b, 0
+	}
+
+	if b.coefficient.Sign() == 0 {
+		return a, NewDecimal(b.coefficient, a.exponent), 0
+	}
+
+	precision := countDigits(large.coefficient)
+	if minPrecision := DecimalPrecision(); precision < minPrecision {
+		precision = minPrecision
+	}
+
+	// The digit is placed two positions below the last significant
+	// digit, so it only breaks ties when the sum is rounded.
+	lowest := large.adjustedExponent() - precision - 1
+	if small.adjustedExponent() >= lowest {
+		return a, b, 0
+	}
+
+	digit := NewDecimal(big.NewInt(int64(small.coefficient.Sign())), lowest)
+	if small == a {
+		return digit, b, precision
+	}
+
+	return a, digit, precision
+}
+
+// roundToPrecision rounds the result of the operation to the number of
+// significant digits, or keeps it exact if the precision is zero.
+func roundToPrecision(result *Decimal, precision int) *Decimal {
+	extra := countDigits(result.coefficient) - precision
+	if precision == 0 || extra <= 0 {
+		return result
+	}
+
+	coefficient := roundQuotient(result.coefficient, pow10(extra), roundHalfEven)
+	if countDigits(coefficient) > precision {
+		return NewDecimal(coefficient.Quo(coefficient, big.NewInt(10)), result.exponent+extra+1)
+	}
+
+	return NewDecimal(coefficient, result.exponent+extra)
+}
+
+// text returns the number in the positional notation without sign, or
+// in the scientific notation if it would need too many zeros.
+func (value *Decimal) text() string {
+	digits := new(big.Int).Abs(value.coefficient).String()
+	adjusted := value.adjustedExponent()
+	if value.exponent > defaultDecimalPrecision || adjusted < -defaultDecimalPrecision {
+		if len(digits) > 1 {
+			digits = digits[:1] + "." + digits[1:]
+		}
+
+		return fmt.Sprintf("%se%+d", digits, adjusted)
+	}
+
+	return value.positional()
+}
+
+// positional returns the number in the positional notation without
+// sign.
+func (value *Decimal) positional() string {
+	digits := new(big.Int).Abs(value.coefficient).String()
+	if value.exponent >= 0 {
+		if value.coefficient.Sign() == 0 {
+			return digits
+		}
+
+		return digits + strings.Repeat("0", value.exponent)
+	}
+
+	point := -value.exponent
+	if len(digits) <= point {
+		digits = strings.Repeat("0", point-len(digits)+1) + digits
+	}
+
+	return digits[:len(digits)-point] + "." + digits[len(digits)-point:]
+}
+
+func decimalAdd(a, b *Decimal) (Object, error) {
+	a, b, precision := dropNegligible(a, b)
+	x, y, exponent := alignDecimals(a, b)
+	return roundToPrecision(NewDecimal(new(big.Int).Add(x, y), exponent), precision), nil
+}
+
+func decimalSub(a, b *Decimal) (Object, error) {
+	a, b, precision := dropNegligible(a, b)
+	x, y, exponent := alignDecimals(a, b)
+	return roundToPrecision(NewDecimal(new(big.Int).Sub(x, y), exponent), precision), nil
+}
+
+func decimalMul(a, b *Decimal) (Object, error) {
+	return checkExponent(NewDecimal(new(big.Int).Mul(a.coefficient, b.coefficient), a.exponent+b.exponent))
+}
+
+func decimalDiv(a, b *Decimal) (Object, error) {
+	if b.coefficient.Sign() == 0 {
+		return nil, NewZeroDivisionError("ділення на нуль")
+	}
+
+	return checkExponent(decimalWithPrecision(new(big.Rat).Quo(a.rat(), b.rat()), a.exponent-b.exponent))
+}
+
+func decimalMod(a, b *Decimal) (Object, error) {
+	if b.coefficient.Sign() == 0 {
+		return nil, NewZeroDivisionError("цілочисельне ділення або за модулем на нуль")
+	}
+
+	if a.coefficient.Sign() == 0 {
+		if a.exponent > b.exponent {
+			return NewDecimal(new(big.Int), b.exponent), nil
+		}
+
+		return a, nil
+	}
+
+	var remainder *big.Int
+	exponent := b.exponent
+	switch {
+	case a.exponent > b.exponent:
+		// a * 10 ** n mod b is computed as (a mod b) * (10 ** n mod b)
+		// mod b, so 10 ** n is not built for the large n.
+		modulus := new(big.Int).Abs(b.coefficient)
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(a.exponent-b.exponent)), modulus)
+		remainder = new(big.Int).Mul(new(big.Int).Rem(a.coefficient, modulus), scale)
+		remainder.Rem(remainder, modulus)
+	case a.adjustedExponent() < b.exponent:
+		// The dividend is less than the lowest digit of the divisor.
+		if a.coefficient.Sign() != b.coefficient.Sign() {
+			return decimalAdd(a, b)
+		}
+
+		return a, nil
+	default:
+		var x, y *big.Int
+		x, y, exponent = alignDecimals(a, b)
+		remainder = new(big.Int).Rem(x, y)
+	}
+
+	if remainder.Sign() != 0 && remainder.Sign() != b.coefficient.Sign() {
+		remainder.Add(remainder, new(big.Int).Mul(b.coefficient, pow10(b.exponent-exponent)))
+	}
+
+	return NewDecimal(remainder, exponent), nil
+}
+
+// decimalPow raises the decimal number to the power, the result is
+// decimal if the exponent is an integer and real otherwise.
+func decimalPow(a, b *Decimal) (Object, error) {
+	exponent := b.rat()
+	if !exponent.IsInt() {
+		base, err := ratToReal(a.rat())
+		if err != nil {
+			return nil, err
+		}
+
+		e, _ := exponent.Float64()
+		return Real(math.Pow(float64(base), e)), nil
+	}
+
+	n := exponent.Num()
+	if n.Sign() < 0 && a.coefficient.Sign() == 0 {
+		return nil, NewZeroDivisionError("неможливо піднести 0 до від'ємного степеня")
+	}
+
+	abs := new(big.Int).Abs(n)
+	if !abs.IsInt64() || abs.Int64() > math.MaxInt32 {
+		if a.coefficient.CmpAbs(big.NewInt(1)) != 0 || a.exponent != 0 {
+			return nil, NewOverflowErrorf("занадто великий показник степеня")
+		}
+
+		// The result is 1 or -1 depending on parity of the exponent.
+		abs = big.NewInt(int64(abs.Bit(0)))
+	}
+
+	if a.coefficient.Sign() != 0 {
+		adjusted := int64(a.adjustedExponent())
+		if adjusted*abs.Int64() > maxDecimalExponent || (adjusted+1)*abs.Int64() < -maxDecimalExponent {
+			return nil, NewOverflowErrorf("показник десяткового числа виходить за межі ±%d", maxDecimalExponent)
+		}
+	}
+
+	result := NewDecimal(new(big.Int).Exp(a.coefficient, abs, nil), a.exponent*int(abs.Int64()))
+	if n.Sign() < 0 {
+		return checkExponent(decimalWithPrecision(new(big.Rat).Inv(result.rat()), -result.exponent))
+	}
+
+	return checkExponent(result)
+}
+
+// operation performs the binary operation with the exact operand
+// using decimalOp, or with the real operand converting the value to
+// Real and using realOp. The value is the right operand if 'reversed'
+// is true. Other operands, including fractions, are not supported,
+// so (nil, nil) is returned.
+func (value *Decimal) operation(
+	ctx Context,
+	other Object,
+	reversed bool,
+	decimalOp func(a, b *Decimal) (Object, error),
+	realOp func(Real, Context, Object) (Object, error),
+) (Object, error) {
+	if otherValue, ok := decimalOperand(other); ok {
+		if reversed {
+			return decimalOp(otherValue, value)
+		}
+
+		return decimalOp(value, otherValue)
+	}
+
+	if otherValue, ok := other.(Real); ok {
+		realValue, err := ratToReal(value.rat())
+		if err != nil {
+			return nil, err
+		}
+
+		return realOp(realValue, ctx, otherValue)
+	}
+
+	return nil, nil
+}
+
+func (value *Decimal) represent(ctx Context) (Object, error) {
+	str, err := value.string(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return String(fmt.Sprintf("%s(\"%s\")", DecimalClass.Name, str)), nil
+}
+
+func (value *Decimal) string(Context) (Object, error) {
+	if value.coefficient.Sign() < 0 {
+		return String("-" + value.text()), nil
+	}
+
+	return String(value.text()), nil
+}
+
+func (value *Decimal) toBool(Context) (Object, error) {
+	return gb2bo(value.coefficient.Sign() != 0), nil
+}
+
+func (value *Decimal) hash(Context) (Object, error) {
+	return ratHash(value.rat())
+}
+
+func (value *Decimal) toInt(Context) (Object, error) {
+	if value.exponent >= 0 {
+		return newBigInt(new(big.Int).Mul(value.coefficient, pow10(value.exponent))), nil
+	}
+
+	return newBigInt(new(big.Int).Quo(value.coefficient, pow10(-value.exponent))), nil
+}
+
+func (value *Decimal) toReal(Context) (Object, error) {
+	return ratToReal(value.rat())
+}
+
+func (value *Decimal) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+func (value *Decimal) add(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, decimalAdd, Real.add)
+}
+
+func (value *Decimal) reversedAdd(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, decimalAdd, Real.reversedAdd)
+}
+
+func (value *Decimal) sub(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, decimalSub, Real.sub)
+}
+
+func (value *Decimal) reversedSub(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, decimalSub, Real.reversedSub)
+}
+
+func (value *Decimal) mul(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, decimalMul, Real.mul)
+}
+
+func (value *Decimal) reversedMul(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, decimalMul, Real.reversedMul)
+}
+
+func (value *Decimal) div(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, decimalDiv, Real.div)
+}
+
+func (value *Decimal) reversedDiv(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, decimalDiv, Real.reversedDiv)
+}
+
+func (value *Decimal) mod(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, decimalMod, Real.mod)
+}
+
+func (value *Decimal) reversedMod(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, decimalMod, Real.reversedMod)
+}
+
+func (value *Decimal) pow(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, decimalPow, Real.pow)
+}
+
+func (value *Decimal) reversedPow(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, decimalPow, Real.reversedPow)
+}
+
+func (value *Decimal) equals(_ Context, other Object) (Object, error) {
+	return ratComparison(
+		value.rat(), other, func(result int, ok bool) bool {
+			return ok && result == 0
+		},
+	)
+}
+
+func (value *Decimal) notEquals(_ Context, other Object) (Object, error) {
+	return ratComparison(
+		value.rat(), other, func(result int, ok bool) bool {
+			return !ok || result != 0
+		},
+	)
+}
+
+func (value *Decimal) less(_ Context, other Object) (Object, error) {
+	return ratOrdering(
+		"<", value, value.rat(), other, func(result int, ok bool) bool {
+			return ok && result < 0
+		},
+	)
+}
+
+func (value *Decimal) lessOrEquals(_ Context, other Object) (Object, error) {
+	return ratOrdering(
+		"<=", value, value.rat(), other, func(result int, ok bool) bool {
+			return ok && result <= 0
+		},
+	)
+}
+
+func (value *Decimal) greater(_ Context, other Object) (Object, error) {
+	return ratOrdering(
+		">", value, value.rat(), other, func(result int, ok bool) bool {
+			return ok && result > 0
+		},
+	)
+}
+
+func (value *Decimal) greaterOrEquals(_ Context, other Object) (Object, error) {
+	return ratOrdering(
+		">=", value, value.rat(), other, func(result int, ok bool) bool {
+			return ok && result >= 0
+		},
+	)
+}
+
+func (value *Decimal) positive(_ Context) (Object, error) {
+	return value, nil
+}
+
+func (value *Decimal) negate(_ Context) (Object, error) {
+	return NewDecimal(new(big.Int).Neg(value.coefficient), value.exponent), nil
+}
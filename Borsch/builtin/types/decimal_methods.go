@@ -0,0 +1,146 @@
+package types
+
+import "math/big"
+
+type decimalMethodFunc func(ctx Context, self *Decimal, args Tuple) (Object, error)
+
+// newDecimalMethod creates a method of 'десяткове' class, the first
+// parameter of which is the decimal number itself.
+func newDecimalMethod(
+	pkg *Package,
+	name string,
+	parameters []MethodParameter,
+	returnType *Class,
+	f decimalMethodFunc,
+) *Method {
+	return MethodNew(
+		name,
+		pkg,
+		append(
+			[]MethodParameter{
+				{
+					Class:      DecimalClass,
+					Classes:    nil,
+					Name:       "я",
+					IsNullable: false,
+					IsVariadic: false,
+				},
+			},
+			parameters...,
+		),
+		[]MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: false,
+			},
+		},
+		func(ctx Context, args Tuple, _ StringDict) (Object, error) {
+			return f(ctx, args[0].(*Decimal), args[1:])
+		},
+	)
+}
+
+// roundingArguments returns the exponent and the rounding mode from
+// the number of digits after the decimal point and the mode name.
+func roundingArguments(places, mode Object) (int, roundingMode, error) {
+	digits, err := smallInt(places)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if digits > maxDecimalExponent || digits < -maxDecimalExponent {
+		return 0, "", NewValueErrorf("кількість знаків виходить за межі ±%d", maxDecimalExponent)
+	}
+
+	roundingMode, err := parseRoundingMode(string(mode.(String)))
+	if err != nil {
+		return 0, "", err
+	}
+
+	return -int(digits), roundingMode, nil
+}
+
+func MakeDecimalClassMethods(pkg *Package) StringDict {
+	methods := []*Method{
+		newDecimalMethod(
+			pkg, "округлити", []MethodParameter{intParameter("знаки"), stringParameter("режим")}, DecimalClass,
+			func(_ Context, self *Decimal, args Tuple) (Object, error) {
+				exponent, mode, err := roundingArguments(args[0], args[1])
+				if err != nil {
+					return nil, err
+				}
+
+				return decimalFromRat(self.rat(), exponent, mode), nil
+			},
+		),
+		newDecimalMethod(
+			pkg,
+			"поділити",
+			[]MethodParameter{objectParameter("дільник"), intParameter("знаки"), stringParameter("режим")},
+			DecimalClass,
+			func(_ Context, self *Decimal, args Tuple) (Object, error) {
+				var divisor *big.Rat
+				switch other := args[0].(type) {
+				case Real:
+					decimal, err := toDecimal(other)
+					if err != nil {
+						return nil, err
+					}
+
+					divisor = decimal.(*Decimal).rat()
+				default:
+					var ok bool
+					if divisor, ok = ratOperand(other); !ok {
+						return nil, NewTypeErrorf(
+							"неможливо поділити десяткове число на об'єкт '%s'", args[0].Class().Name,
+						)
+					}
+				}
+
+				if divisor.Sign() == 0 {
+					return nil, NewZeroDivisionError("ділення на нуль")
+				}
+
+				exponent, mode, err := roundingArguments(args[1], args[2])
+				if err != nil {
+					return nil, err
+				}
+
+				return decimalFromRat(new(big.Rat).Quo(self.rat(), divisor), exponent, mode), nil
+			},
+		),
+	}
+
+	methods = append(
+		methods,
+		MethodNew(
+			"точність", pkg, nil, []MethodReturnType{{Class: IntClass, IsNullable: false}},
+			func(_ Context, _ Tuple, _ StringDict) (Object, error) {
+				return Int(DecimalPrecision()), nil
+			},
+		),
+		MethodNew(
+			"встановити_точність", pkg, []MethodParameter{intParameter("точність")},
+			[]MethodReturnType{{Class: NilClass, IsNullable: true}},
+			func(_ Context, args Tuple, _ StringDict) (Object, error) {
+				precision, err := smallInt(args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				if err = SetDecimalPrecision(int(precision)); err != nil {
+					return nil, err
+				}
+
+				return Nil, nil
+			},
+		),
+	)
+
+	dict := StringDict{}
+	for _, method := range methods {
+		dict[method.Name] = method
+	}
+
+	return dict
+}
@@ -0,0 +1,145 @@
+package types
+
+import "fmt"
+
+var DefaultDictClass = ObjectClass.ClassNew(
+	"словник_за_замовчуванням", map[string]Object{}, true, DefaultDictNew, nil,
+)
+
+// DefaultDict is a dictionary, which calls the factory without
+// arguments to create the value of the absent key and stores it.
+type DefaultDict struct {
+	dict    *Dictionary
+	factory Object
+}
+
+// DefaultDictNew creates the dictionary with the factory, which may be
+// нуль, and optional entries of the other dictionary.
+func DefaultDictNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	if len(args) == 0 || len(args) > 2 {
+		return nil, NewTypeErrorf("%s() приймає від 1 до 2 аргументів (отримано %d)", cls.Name, len(args))
+	}
+
+	factory := args[0]
+	if factory != Nil {
+		if _, ok := factory.(ICall); !ok {
+			return nil, NewTypeErrorf("фабрика має бути функцією або нулем, отримано '%s'", factory.Class().Name)
+		}
+	}
+
+	defaultDict := &DefaultDict{dict: NewDictionary(), factory: factory}
+	if len(args) == 2 {
+		if err := fillDictionary(ctx, cls, defaultDict.dict, args[1]); err != nil {
+			return nil, err
+		}
+	}
+
+	return defaultDict, nil
+}
+
+func (value *DefaultDict) Class() *Class {
+	return DefaultDictClass
+}
+
+func (value *DefaultDict) dictionary() *Dictionary {
+	return value.dict
+}
+
+func (value *DefaultDict) Length(ctx Context) (Int, error) {
+	return value.dict.Length(ctx)
+}
+
+func (value *DefaultDict) GetItem(ctx Context, key Object) (Object, error) {
+	_, index, err := value.dict.find(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if index != -1 {
+		return value.dict.entries[index].value, nil
+	}
+
+	if value.factory == Nil {
+		return nil, newKeyNotFoundError(ctx, key)
+	}
+
+	item, err := Call(ctx, value.factory, Tuple{})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := value.dict.SetItem(ctx, key, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (value *DefaultDict) SetItem(ctx Context, key Object, item Object) (Object, error) {
+	if _, err := value.dict.SetItem(ctx, key, item); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *DefaultDict) DeleteItem(ctx Context, key Object) (Object, error) {
+	return value.dict.DeleteItem(ctx, key)
+}
+
+func (value *DefaultDict) represent(ctx Context) (Object, error) {
+	factoryStr, err := Represent(ctx, value.factory)
+	if err != nil {
+		return nil, err
+	}
+
+	return representMapping(ctx, value, fmt.Sprintf("%s, ", factoryStr))
+}
+
+func (value *DefaultDict) string(ctx Context) (Object, error) {
+	return value.represent(ctx)
+}
+
+func (value *DefaultDict) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+// equals compares entries regardless of the order and of the factory.
+func (value *DefaultDict) equals(ctx Context, other Object) (Object, error) {
+	return value.dict.equals(ctx, other)
+}
+
+func (value *DefaultDict) notEquals(ctx Context, other Object) (Object, error) {
+	result, err := value.equals(ctx, other)
+	if err != nil {
+		return nil, err
+	}
+
+	return !result.(Bool), nil
+}
+
+func (value *DefaultDict) toBool(ctx Context) (Object, error) {
+	return value.dict.toBool(ctx)
+}
+
+func (value *DefaultDict) contains(ctx Context, item Object) (Object, error) {
+	return value.dict.contains(ctx, item)
+}
+
+func (value *DefaultDict) iterate(ctx Context) (Object, error) {
+	return value.dict.iterate(ctx)
+}
+
+func (value *DefaultDict) copy(ctx Context) (Object, error) {
+	return DefaultDictNew(ctx, DefaultDictClass, Tuple{value.factory, value})
+}
+
+func (value *DefaultDict) deepCopy(ctx Context, memo map[Object]Object) (Object, error) {
+	defaultDict := &DefaultDict{dict: NewDictionary(), factory: value.factory}
+	memo[value] = defaultDict
+	if err := deepCopyDictionary(ctx, defaultDict.dict, value.dict, memo); err != nil {
+		return nil, err
+	}
+
+	return defaultDict, nil
+}
@@ -0,0 +1,363 @@
+package types
+
+import "fmt"
+
+var (
+	DequeClass = ObjectClass.ClassNew("дек", map[string]Object{}, true, DequeNew, nil)
+	QueueClass = ObjectClass.ClassNew("черга", map[string]Object{}, true, QueueNew, nil)
+)
+
+// ring is a circular buffer, which adds and removes elements at both
+// ends in O(1).
+type ring struct {
+	buffer []Object
+	head   int
+	size   int
+}
+
+// ringBased is a collection, which stores its elements in the ring, so
+// methods of queues are shared.
+type ringBased interface {
+	Object
+	base() *ring
+}
+
+func (value *ring) base() *ring {
+	return value
+}
+
+func (value *ring) index(i int) int {
+	return (value.head + i) % len(value.buffer)
+}
+
+func (value *ring) grow() {
+	if value.size < len(value.buffer) {
+		return
+	}
+
+	buffer := make([]Object, 2*len(value.buffer)+4)
+	copy(buffer, value.elements())
+	value.buffer, value.head = buffer, 0
+}
+
+func (value *ring) pushBack(element Object) {
+	value.grow()
+	value.buffer[value.index(value.size)] = element
+	value.size++
+}
+
+func (value *ring) pushFront(element Object) {
+	value.grow()
+	value.head = (value.head + len(value.buffer) - 1) % len(value.buffer)
+	value.buffer[value.head] = element
+	value.size++
+}
+
+func (value *ring) popBack() Object {
+	value.size--
+	i := value.index(value.size)
+	element := value.buffer[i]
+	value.buffer[i] = nil
+	return element
+}
+
+func (value *ring) popFront() Object {
+	element := value.buffer[value.head]
+	value.buffer[value.head] = nil
+	value.head = value.index(1)
+	value.size--
+	return element
+}
+
+func (value *ring) at(i int) Object {
+	return value.buffer[value.index(i)]
+}
+
+func (value *ring) clear() {
+	value.buffer, value.head, value.size = nil, 0, 0
+}
+
+// elements returns elements from the front to the back.
+func (value *ring) elements() []Object {
+	elements := make([]Object, value.size)
+	for i := range elements {
+		elements[i] = value.at(i)
+	}
+
+	return elements
+}
+
+// fill adds elements of the optional iterable argument to the back.
+func (value *ring) fill(ctx Context, cls *Class, args Tuple) error {
+	switch len(args) {
+	case 0:
+		return nil
+	case 1:
+		return IterateOver(
+			ctx, args[0], func(element Object) (bool, error) {
+				value.pushBack(element)
+				return false, nil
+			},
+		)
+	default:
+		return NewTypeErrorf("%s() приймає не більше 1 аргументу (отримано %d)", cls.Name, len(args))
+	}
+}
+
+func (value *ring) represent(ctx Context, container Object) (Object, error) {
+	return representContainer(
+		container, String(fmt.Sprintf("%s([...])", container.Class().Name)), func() (Object, error) {
+			str, err := representElements(ctx, value.elements())
+			if err != nil {
+				return nil, err
+			}
+
+			return String(fmt.Sprintf("%s([%s])", container.Class().Name, str)), nil
+		},
+	)
+}
+
+// checkNotEmpty returns the error with the message if there is nothing
+// to take from the container.
+func checkNotEmpty(size int, message string) error {
+	if size == 0 {
+		return NewIndexOutOfRangeError(message)
+	}
+
+	return nil
+}
+
+// representElements joins representations of elements with ", ".
+func representElements(ctx Context, elements []Object) (String, error) {
+	str := String("")
+	for i, element := range elements {
+		elementStr, err := Represent(ctx, element)
+		if err != nil {
+			return "", err
+		}
+
+		str += elementStr.(String)
+		if i < len(elements)-1 {
+			str += ", "
+		}
+	}
+
+	return str, nil
+}
+
+// Deque is a double-ended queue, elements are added and removed at
+// both ends in O(1) and accessed by their index.
+type Deque struct {
+	ring
+}
+
+func DequeNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	deque := &Deque{}
+	if err := deque.fill(ctx, cls, args); err != nil {
+		return nil, err
+	}
+
+	return deque, nil
+}
+
+func (value *Deque) Class() *Class {
+	return DequeClass
+}
+
+// Rotate moves n elements from the back to the front, or from the front
+// to the back if n is negative.
+func (value *Deque) Rotate(n int) {
+	if value.size == 0 {
+		return
+	}
+
+	n %= value.size
+	if n < 0 {
+		n += value.size
+	}
+
+	for i := 0; i < n; i++ {
+		value.pushFront(value.popBack())
+	}
+}
+
+func (value *Deque) represent(ctx Context) (Object, error) {
+	return value.ring.represent(ctx, value)
+}
+
+func (value *Deque) string(ctx Context) (Object, error) {
+	return value.represent(ctx)
+}
+
+func (value *Deque) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+func (value *Deque) Length(_ Context) (Int, error) {
+	return Int(value.size), nil
+}
+
+func (value *Deque) normalizeIndex(index Int) (int, error) {
+	if index < 0 {
+		index += Int(value.size)
+	}
+
+	if err := checkIndex(index, Int(value.size), "деку"); err != nil {
+		return 0, err
+	}
+
+	return int(index), nil
+}
+
+func (value *Deque) GetElement(_ Context, index Int) (Object, error) {
+	i, err := value.normalizeIndex(index)
+	if err != nil {
+		return nil, err
+	}
+
+	return value.at(i), nil
+}
+
+func (value *Deque) SetElement(_ Context, index Int, item Object) (Object, error) {
+	i, err := value.normalizeIndex(index)
+	if err != nil {
+		return nil, err
+	}
+
+	value.buffer[value.index(i)] = item
+	return value, nil
+}
+
+func (value *Deque) Slice(ctx Context, leftBound, rightBound Int) (Object, error) {
+	list := NewList()
+	list.Values = value.elements()
+	slice, err := list.Slice(ctx, leftBound, rightBound)
+	if err != nil {
+		return nil, err
+	}
+
+	return DequeNew(ctx, DequeClass, Tuple{slice})
+}
+
+func (value *Deque) toBool(_ Context) (Object, error) {
+	return gb2bo(value.size != 0), nil
+}
+
+func (value *Deque) contains(ctx Context, item Object) (Object, error) {
+	return containsElement(ctx, value.elements(), item)
+}
+
+func (value *Deque) iterate(_ Context) (Object, error) {
+	return &sliceIterator{elements: value.elements(), index: 0}, nil
+}
+
+func (value *Deque) equals(ctx Context, other Object) (Object, error) {
+	if d, ok := other.(*Deque); ok {
+		result, err := compareContainers(
+			value, d, func() (int, error) {
+				return compareSequences(ctx, value.elements(), d.elements(), true)
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return gb2bo(result == 0), nil
+	}
+
+	return False, nil
+}
+
+func (value *Deque) notEquals(ctx Context, other Object) (Object, error) {
+	result, err := value.equals(ctx, other)
+	if err != nil {
+		return nil, err
+	}
+
+	return !result.(Bool), nil
+}
+
+func (value *Deque) copy(ctx Context) (Object, error) {
+	return DequeNew(ctx, DequeClass, Tuple{value})
+}
+
+func (value *Deque) deepCopy(ctx Context, memo map[Object]Object) (Object, error) {
+	deque := &Deque{}
+	memo[value] = deque
+	elements := value.elements()
+	if err := deepCopyElements(ctx, elements, elements, memo); err != nil {
+		return nil, err
+	}
+
+	for _, element := range elements {
+		deque.pushBack(element)
+	}
+
+	return deque, nil
+}
+
+// Queue is a first-in-first-out queue, elements are added to the back
+// and taken from the front.
+type Queue struct {
+	ring
+}
+
+func QueueNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	queue := &Queue{}
+	if err := queue.fill(ctx, cls, args); err != nil {
+		return nil, err
+	}
+
+	return queue, nil
+}
+
+func (value *Queue) Class() *Class {
+	return QueueClass
+}
+
+func (value *Queue) represent(ctx Context) (Object, error) {
+	return value.ring.represent(ctx, value)
+}
+
+func (value *Queue) string(ctx Context) (Object, error) {
+	return value.represent(ctx)
+}
+
+func (value *Queue) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+func (value *Queue) Length(_ Context) (Int, error) {
+	return Int(value.size), nil
+}
+
+func (value *Queue) toBool(_ Context) (Object, error) {
+	return gb2bo(value.size != 0), nil
+}
+
+func (value *Queue) contains(ctx Context, item Object) (Object, error) {
+	return containsElement(ctx, value.elements(), item)
+}
+
+func (value *Queue) iterate(_ Context) (Object, error) {
+	return &sliceIterator{elements: value.elements(), index: 0}, nil
+}
+
+func (value *Queue) copy(ctx Context) (Object, error) {
+	return QueueNew(ctx, QueueClass, Tuple{value})
+}
+
+func (value *Queue) deepCopy(ctx Context, memo map[Object]Object) (Object, error) {
+	queue := &Queue{}
+	memo[value] = queue
+	elements := value.elements()
+	if err := deepCopyElements(ctx, elements, elements, memo); err != nil {
+		return nil, err
+	}
+
+	for _, element := range elements {
+		queue.pushBack(element)
+	}
+
+	return queue, nil
+}
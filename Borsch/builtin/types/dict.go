@@ -1,3 +1,283 @@
 package types
 
+import "fmt"
+
 type StringDict map[string]Object
+
+var DictionaryClass = ObjectClass.ClassNew("словник", map[string]Object{}, true, DictionaryNew, nil)
+
+type dictionaryEntry struct {
+	key   Object
+	value Object
+}
+
+// Dictionary maps hashable keys to values and preserves
+// the insertion order of the keys.
+type Dictionary struct {
+	entries []dictionaryEntry
+
+	// buckets maps hashes of keys to indices of entries.
+	buckets map[Int][]int
+}
+
+func NewDictionary() *Dictionary {
+	return &Dictionary{entries: nil, buckets: map[Int][]int{}}
+}
+
+func (value *Dictionary) Class() *Class {
+	return DictionaryClass
+}
+
+func DictionaryNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	dict := NewDictionary()
+	switch len(args) {
+	case 0:
+		return dict, nil
+	case 1:
+		if err := fillDictionary(ctx, cls, dict, args[0]); err != nil {
+			return nil, err
+		}
+
+		return dict, nil
+	default:
+		return nil, NewTypeErrorf("%s() приймає не більше 1 аргументу (отримано %d)", cls.Name, len(args))
+	}
+}
+
+// find returns the hash of the key and the index of its entry,
+// or -1 if the key is absent.
+func (value *Dictionary) find(ctx Context, key Object) (Int, int, error) {
+	hash, err := Hash(ctx, key)
+	if err != nil {
+		return 0, -1, err
+	}
+
+	for _, index := range value.buckets[hash.(Int)] {
+		equal, err := goBool(ctx, Equals, value.entries[index].key, key)
+		if err != nil {
+			return 0, -1, err
+		}
+
+		if equal {
+			return hash.(Int), index, nil
+		}
+	}
+
+	return hash.(Int), -1, nil
+}
+
+func (value *Dictionary) Length(_ Context) (Int, error) {
+	return Int(len(value.entries)), nil
+}
+
+func (value *Dictionary) GetItem(ctx Context, key Object) (Object, error) {
+	_, index, err := value.find(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if index == -1 {
+		return nil, newKeyNotFoundError(ctx, key)
+	}
+
+	return value.entries[index].value, nil
+}
+
+func (value *Dictionary) SetItem(ctx Context, key Object, item Object) (Object, error) {
+	hash, index, err := value.find(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if index != -1 {
+		value.entries[index].value = item
+		return value, nil
+	}
+
+	value.buckets[hash] = append(value.buckets[hash], len(value.entries))
+	value.entries = append(value.entries, dictionaryEntry{key: key, value: item})
+	return value, nil
+}
+
+func (value *Dictionary) DeleteItem(ctx Context, key Object) (Object, error) {
+	_, index, err := value.find(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if index == -1 {
+		return nil, newKeyNotFoundError(ctx, key)
+	}
+
+	item := value.entries[index].value
+	value.entries = append(value.entries[:index], value.entries[index+1:]...)
+
+	// Indices of the following entries are shifted, so the buckets
+	// have to be rebuilt.
+	if err := value.rebuildBuckets(ctx); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// rebuildBuckets maps hashes of keys to indices of entries again after
+// entries are removed or reordered.
+func (value *Dictionary) rebuildBuckets(ctx Context) error {
+	value.buckets = map[Int][]int{}
+	for i, entry := range value.entries {
+		hash, err := Hash(ctx, entry.key)
+		if err != nil {
+			return err
+		}
+
+		value.buckets[hash.(Int)] = append(value.buckets[hash.(Int)], i)
+	}
+
+	return nil
+}
+
+// Keys returns keys of the dictionary in the insertion order.
+func (value *Dictionary) Keys() []Object {
+	keys := make([]Object, len(value.entries))
+	for i, entry := range value.entries {
+		keys[i] = entry.key
+	}
+
+	return keys
+}
+
+func (value *Dictionary) represent(ctx Context) (Object, error) {
+	return value.string(ctx)
+}
+
+func (value *Dictionary) string(ctx Context) (Object, error) {
+	return representContainer(
+		value, "{...}", func() (Object, error) {
+			str := String("")
+			for i, entry := range value.entries {
+				keyStr, err := Represent(ctx, entry.key)
+				if err != nil {
+					return nil, err
+				}
+
+				valueStr, err := Represent(ctx, entry.value)
+				if err != nil {
+					return nil, err
+				}
+
+				str += keyStr.(String) + ": " + valueStr.(String)
+				if i < len(value.entries)-1 {
+					str += ", "
+				}
+			}
+
+			return String(fmt.Sprintf("{%s}", str)), nil
+		},
+	)
+}
+
+func (value *Dictionary) equals(ctx Context, other Object) (Object, error) {
+	if d, ok := asDictionary(other); ok {
+		result, err := value.compareWith(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+
+		return gb2bo(result == 0), nil
+	}
+
+	return False, nil
+}
+
+func (value *Dictionary) notEquals(ctx Context, other Object) (Object, error) {
+	if d, ok := asDictionary(other); ok {
+		result, err := value.compareWith(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+
+		return gb2bo(result != 0), nil
+	}
+
+	return True, nil
+}
+
+// compareWith returns 0 if both dictionaries have equal keys
+// mapped to equal values regardless of the order, or 1 otherwise.
+func (value *Dictionary) compareWith(ctx Context, other *Dictionary) (int, error) {
+	return compareContainers(
+		value, other, func() (int, error) {
+			if len(value.entries) != len(other.entries) {
+				return 1, nil
+			}
+
+			for _, entry := range value.entries {
+				_, index, err := other.find(ctx, entry.key)
+				if err != nil {
+					return 0, err
+				}
+
+				if index == -1 {
+					return 1, nil
+				}
+
+				otherValue := other.entries[index].value
+				if Is(entry.value, otherValue) {
+					continue
+				}
+
+				equal, err := goBool(ctx, Equals, entry.value, otherValue)
+				if err != nil {
+					return 0, err
+				}
+
+				if !equal {
+					return 1, nil
+				}
+			}
+
+			return 0, nil
+		},
+	)
+}
+
+func (value *Dictionary) copy(ctx Context) (Object, error) {
+	return DictionaryNew(ctx, DictionaryClass, Tuple{value})
+}
+
+func (value *Dictionary) deepCopy(ctx Context, memo map[Object]Object) (Object, error) {
+	dict := NewDictionary()
+	memo[value] = dict
+	if err := deepCopyDictionary(ctx, dict, value, memo); err != nil {
+		return nil, err
+	}
+
+	return dict, nil
+}
+
+func (value *Dictionary) toBool(_ Context) (Object, error) {
+	return gb2bo(len(value.entries) != 0), nil
+}
+
+func (value *Dictionary) contains(ctx Context, item Object) (Object, error) {
+	_, index, err := value.find(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return gb2bo(index != -1), nil
+}
+
+func (value *Dictionary) iterate(_ Context) (Object, error) {
+	return &sliceIterator{elements: value.Keys()}, nil
+}
+
+func newKeyNotFoundError(ctx Context, key Object) error {
+	keyStr, err := Represent(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return NewKeyErrorf("ключ %s не знайдено", keyStr)
+}
@@ -0,0 +1,307 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+var DurationClass = ObjectClass.ClassNew("тривалість", map[string]Object{}, true, DurationNew, nil)
+
+// Duration is a signed time interval with nanosecond precision, which
+// is created from the number of seconds, i.e. тривалість(1.5).
+// Durations are added to and subtracted from dates, multiplied and
+// divided by numbers.
+type Duration time.Duration
+
+func DurationNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	switch len(args) {
+	case 0:
+		return Duration(0), nil
+	case 1:
+		if value, ok := args[0].(Duration); ok {
+			return value, nil
+		}
+
+		return DurationFromSeconds(ctx, args[0])
+	default:
+		return nil, NewTypeErrorf("%s() приймає не більше 1 аргументу (отримано %d)", cls.Name, len(args))
+	}
+}
+
+// realOperand converts a number, but not a string or a complex number,
+// to float64.
+func realOperand(ctx Context, other Object) (float64, bool, error) {
+	switch other.(type) {
+	case Int, Bool, *BigInt, Real, *Fraction, *Decimal:
+		value, err := ToReal(ctx, other)
+		if err != nil {
+			return 0, true, err
+		}
+
+		return float64(value.(Real)), true, nil
+	default:
+		return 0, false, nil
+	}
+}
+
+func durationFromNanoseconds(nanoseconds float64) (Duration, error) {
+	if math.IsNaN(nanoseconds) {
+		return 0, NewValueErrorf("тривалість не може бути NaN")
+	}
+
+	nanoseconds = math.Round(nanoseconds)
+	if nanoseconds >= math.MaxInt64 || nanoseconds < math.MinInt64 {
+		return 0, NewOverflowErrorf("тривалість занадто велика")
+	}
+
+	return Duration(nanoseconds), nil
+}
+
+// DurationFromSeconds converts the number of seconds to Duration,
+// rounding it to nanoseconds.
+func DurationFromSeconds(ctx Context, seconds Object) (Duration, error) {
+	if value, ok := seconds.(Int); ok {
+		if value > math.MaxInt64/Int(time.Second) || value < math.MinInt64/Int(time.Second) {
+			return 0, NewOverflowErrorf("тривалість занадто велика")
+		}
+
+		return Duration(value) * Duration(time.Second), nil
+	}
+
+	value, ok, err := realOperand(ctx, seconds)
+	if err != nil {
+		return 0, err
+	}
+
+	if !ok {
+		return 0, NewTypeErrorf("тривалість задається числом секунд, отримано '%s'", seconds.Class().Name)
+	}
+
+	return durationFromNanoseconds(value * float64(time.Second))
+}
+
+func (value Duration) Class() *Class {
+	return DurationClass
+}
+
+// Seconds returns the duration as a real number of seconds.
+func (value Duration) Seconds() float64 {
+	return time.Duration(value).Seconds()
+}
+
+// format formats the duration as whole seconds or, if the unit is an
+// hour, as hours, minutes and seconds, followed by the fraction of
+// a second without trailing zeros.
+func (value Duration) format(unit time.Duration, format string) string {
+	abs := uint64(value)
+	sign := ""
+	if value < 0 {
+		abs = -abs
+		sign = "-"
+	}
+
+	fraction := ""
+	if nanoseconds := abs % uint64(time.Second); nanoseconds != 0 {
+		fraction = strings.TrimRight(fmt.Sprintf(".%09d", nanoseconds), "0")
+	}
+
+	seconds := abs / uint64(time.Second)
+	if unit == time.Second {
+		return fmt.Sprintf(format, sign, seconds, fraction)
+	}
+
+	return fmt.Sprintf(format, sign, seconds/3600, seconds/60%60, seconds%60, fraction)
+}
+
+func (value Duration) represent(Context) (Object, error) {
+	return String(value.format(time.Second, DurationClass.Name+"(%s%d%s)")), nil
+}
+
+// string formats the duration as hours, minutes and seconds, i.e.
+// "1:02:03.5".
+func (value Duration) string(Context) (Object, error) {
+	return String(value.format(time.Hour, "%s%d:%02d:%02d%s")), nil
+}
+
+func (value Duration) toBool(Context) (Object, error) {
+	return gb2bo(value != 0), nil
+}
+
+func (value Duration) hash(Context) (Object, error) {
+	return Int(value), nil
+}
+
+func (value Duration) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+func durationAdd(a, b Duration) (Object, error) {
+	result := a + b
+	if (result > a) != (b > 0) {
+		return nil, NewOverflowErrorf("тривалість занадто велика")
+	}
+
+	return result, nil
+}
+
+// scale multiplies the duration by the number, integers are multiplied
+// exactly.
+func (value Duration) scale(ctx Context, other Object) (Object, error) {
+	if factor, ok := other.(Int); ok {
+		result := value * Duration(factor)
+		if factor != 0 && (result/Duration(factor) != value || factor == -1 && value == math.MinInt64) {
+			return nil, NewOverflowErrorf("тривалість занадто велика")
+		}
+
+		return result, nil
+	}
+
+	factor, ok, err := realOperand(ctx, other)
+	if !ok || err != nil {
+		return nil, err
+	}
+
+	return durationFromNanoseconds(float64(value) * factor)
+}
+
+func (value Duration) add(_ Context, other Object) (Object, error) {
+	switch otherValue := other.(type) {
+	case Duration:
+		return durationAdd(value, otherValue)
+	case DateTime:
+		return otherValue.addDuration(value)
+	default:
+		return nil, nil
+	}
+}
+
+func (value Duration) sub(_ Context, other Object) (Object, error) {
+	if otherValue, ok := other.(Duration); ok {
+		if otherValue == math.MinInt64 {
+			return nil, NewOverflowErrorf("тривалість занадто велика")
+		}
+
+		return durationAdd(value, -otherValue)
+	}
+
+	return nil, nil
+}
+
+func (value Duration) mul(ctx Context, other Object) (Object, error) {
+	return value.scale(ctx, other)
+}
+
+func (value Duration) reversedMul(ctx Context, other Object) (Object, error) {
+	return value.scale(ctx, other)
+}
+
+// div divides the duration by the number giving a duration, or by the
+// duration giving a real number.
+func (value Duration) div(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(Duration); ok {
+		if otherValue == 0 {
+			return nil, NewZeroDivisionError("ділення на нульову тривалість")
+		}
+
+		return Real(float64(value) / float64(otherValue)), nil
+	}
+
+	divisor, ok, err := realOperand(ctx, other)
+	if !ok || err != nil {
+		return nil, err
+	}
+
+	if divisor == 0 {
+		return nil, NewZeroDivisionError("ділення тривалості на нуль")
+	}
+
+	return durationFromNanoseconds(float64(value) / divisor)
+}
+
+func (value Duration) mod(_ Context, other Object) (Object, error) {
+	otherValue, ok := other.(Duration)
+	if !ok {
+		return nil, nil
+	}
+
+	if otherValue == 0 {
+		return nil, NewZeroDivisionError("ділення на нульову тривалість")
+	}
+
+	result := value % otherValue
+	if result != 0 && (result < 0) != (otherValue < 0) {
+		result += otherValue
+	}
+
+	return result, nil
+}
+
+func durationComparison(value Duration, other Object, predicate func(a, b Duration) bool) (Object, error) {
+	if otherValue, ok := other.(Duration); ok {
+		return gb2bo(predicate(value, otherValue)), nil
+	}
+
+	return nil, nil
+}
+
+func (value Duration) equals(_ Context, other Object) (Object, error) {
+	return durationComparison(
+		value, other, func(a, b Duration) bool {
+			return a == b
+		},
+	)
+}
+
+func (value Duration) notEquals(_ Context, other Object) (Object, error) {
+	return durationComparison(
+		value, other, func(a, b Duration) bool {
+			return a != b
+		},
+	)
+}
+
+func (value Duration) less(_ Context, other Object) (Object, error) {
+	return durationComparison(
+		value, other, func(a, b Duration) bool {
+			return a < b
+		},
+	)
+}
+
+func (value Duration) lessOrEquals(_ Context, other Object) (Object, error) {
+	return durationComparison(
+		value, other, func(a, b Duration) bool {
+			return a <= b
+		},
+	)
+}
+
+func (value Duration) greater(_ Context, other Object) (Object, error) {
+	return durationComparison(
+		value, other, func(a, b Duration) bool {
+			return a > b
+		},
+	)
+}
+
+func (value Duration) greaterOrEquals(_ Context, other Object) (Object, error) {
+	return durationComparison(
+		value, other, func(a, b Duration) bool {
+			return a >= b
+		},
+	)
+}
+
+func (value Duration) positive(Context) (Object, error) {
+	return value, nil
+}
+
+func (value Duration) negate(Context) (Object, error) {
+	if value == math.MinInt64 {
+		return nil, NewOverflowErrorf("тривалість занадто велика")
+	}
+
+	return -value, nil
+}
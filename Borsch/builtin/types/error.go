@@ -32,6 +32,11 @@ func (value *Error) init() {
 }
 
 func ErrorConstruct(ctx Context, self Object, args Tuple) error {
+	if _, ok := self.(ISetAttribute); !ok {
+		// Native errors receive the message when they are created.
+		return nil
+	}
+
 	message, err := errorMessageFromArgs(ctx, nil, args)
 	if err != nil {
 		return err
@@ -0,0 +1,15 @@
+package types
+
+import "fmt"
+
+// ExitError is returned by 'вийти()' to stop the program with the exit
+// code. The interpreter unwinds statements with it as a result with the
+// exit state, so handler blocks can not catch it, and returns it from
+// calls of functions and packages up to the caller of the interpreter.
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("вихід з кодом %d", e.Code)
+}
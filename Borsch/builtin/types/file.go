@@ -0,0 +1,350 @@
+package types
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"unicode/utf8"
+)
+
+var FileClass = ObjectClass.ClassNew("файл", map[string]Object{}, true, nil, nil)
+
+// File is an opened file. Text files are read and written as strings
+// in UTF-8, binary files as lists of integers from 0 to 255. Iteration
+// over the file gives its lines including the line break.
+type File struct {
+	file     *os.File
+	reader   *bufio.Reader
+	writer   *bufio.Writer
+	mode     string
+	readable bool
+	writable bool
+	binary   bool
+	closed   bool
+}
+
+// parseFileMode converts the mode of 'відкрити()' to flags of os.OpenFile.
+// The mode starts with one of:
+//
+//	ч - читання, файл має існувати
+//	з - запис, файл створюється або очищується
+//	д - дописування в кінець файлу, файл створюється
+//	с - створення, файл не має існувати
+//
+// followed by '+' for both reading and writing and 'б' for the binary mode.
+func parseFileMode(mode string) (flag int, readable, writable, binary bool, err error) {
+	runes := []rune(mode)
+	if len(runes) == 0 {
+		return 0, false, false, false, NewValueErrorf("порожній режим відкриття файлу")
+	}
+
+	switch runes[0] {
+	case 'ч':
+		flag, readable = os.O_RDONLY, true
+	case 'з':
+		flag, writable = os.O_WRONLY|os.O_CREATE|os.O_TRUNC, true
+	case 'д':
+		flag, writable = os.O_WRONLY|os.O_CREATE|os.O_APPEND, true
+	case 'с':
+		flag, writable = os.O_WRONLY|os.O_CREATE|os.O_EXCL, true
+	default:
+		return 0, false, false, false, NewValueErrorf("некоректний режим відкриття файлу: '%s'", mode)
+	}
+
+	plus := false
+	for _, r := range runes[1:] {
+		switch {
+		case r == '+' && !plus:
+			plus = true
+			readable, writable = true, true
+			flag = flag&^(os.O_RDONLY|os.O_WRONLY) | os.O_RDWR
+		case r == 'б' && !binary:
+			binary = true
+		default:
+			return 0, false, false, false, NewValueErrorf("некоректний режим відкриття файлу: '%s'", mode)
+		}
+	}
+
+	return flag, readable, writable, binary, nil
+}
+
+// FileOpen opens the file using the mode of 'відкрити()'.
+func FileOpen(path, mode string) (*File, error) {
+	flag, readable, writable, binary, err := parseFileMode(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, flag, 0666)
+	if err != nil {
+		return nil, IOErrorFromGo(err)
+	}
+
+	return &File{
+		file:     file,
+		reader:   bufio.NewReader(file),
+		mode:     mode,
+		readable: readable,
+		writable: writable,
+		binary:   binary,
+	}, nil
+}
+
+// FileFromGo wraps the file opened for reading and writing, i.e.
+// a temporary file.
+func FileFromGo(file *os.File, binary bool) *File {
+	mode := "з+"
+	if binary {
+		mode += "б"
+	}
+
+	return &File{
+		file:     file,
+		reader:   bufio.NewReader(file),
+		mode:     mode,
+		readable: true,
+		writable: true,
+		binary:   binary,
+	}
+}
+
+// BytesToList converts bytes to the list of integers.
+func BytesToList(data []byte) *List {
+	list := &List{Values: make([]Object, len(data))}
+	for i, b := range data {
+		list.Values[i] = Int(b)
+	}
+
+	return list
+}
+
+// BytesFromObject converts the iterable of integers from 0 to 255
+// to bytes.
+func BytesFromObject(ctx Context, value Object) ([]byte, error) {
+	var data []byte
+	err := IterateOver(
+		ctx, value, func(element Object) (bool, error) {
+			b, ok := element.(Int)
+			if !ok {
+				return false, NewTypeErrorf("байт має бути цілим числом, отримано '%s'", element.Class().Name)
+			}
+
+			if b < 0 || b > 255 {
+				return false, NewValueErrorf("байт має бути в діапазоні від 0 до 255, отримано %d", b)
+			}
+
+			data = append(data, byte(b))
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (value *File) Class() *Class {
+	return FileClass
+}
+
+func (value *File) Name() string {
+	return value.file.Name()
+}
+
+func (value *File) represent(ctx Context) (Object, error) {
+	return value.string(ctx)
+}
+
+func (value *File) string(Context) (Object, error) {
+	state := ""
+	if value.closed {
+		state = " (закритий)"
+	}
+
+	return String(fmt.Sprintf("<файл '%s', режим '%s'%s>", value.Name(), value.mode, state)), nil
+}
+
+func (value *File) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+func (value *File) check(reading bool) error {
+	if value.closed {
+		return NewValueErrorf("операція з закритим файлом")
+	}
+
+	if reading && !value.readable {
+		return NewIOErrorf("файл не відкрито для читання")
+	}
+
+	if !reading && !value.writable {
+		return NewIOErrorf("файл не відкрито для запису")
+	}
+
+	// Reading of the standard input or writing to the standard error
+	// must follow everything printed to the standard output before.
+	if reading && value == Stdin || !reading && value == Stderr {
+		return Stdout.Flush()
+	}
+
+	return nil
+}
+
+func (value *File) decode(data []byte) (Object, error) {
+	if value.binary {
+		return BytesToList(data), nil
+	}
+
+	if !utf8.Valid(data) {
+		return nil, NewValueErrorf("файл '%s' містить некоректні символи UTF-8", value.Name())
+	}
+
+	return String(data), nil
+}
+
+// Read reads the rest of the file.
+func (value *File) Read() (Object, error) {
+	if err := value.check(true); err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(value.reader)
+	if err != nil {
+		return nil, IOErrorFromGo(err)
+	}
+
+	return value.decode(data)
+}
+
+func (value *File) readLine() ([]byte, error) {
+	if err := value.check(true); err != nil {
+		return nil, err
+	}
+
+	data, err := value.reader.ReadBytes('\n')
+	if err != nil && err != io.EOF {
+		return nil, IOErrorFromGo(err)
+	}
+
+	return data, nil
+}
+
+// ReadLine reads the line including the line break, the result is
+// empty at the end of the file.
+func (value *File) ReadLine() (Object, error) {
+	data, err := value.readLine()
+	if err != nil {
+		return nil, err
+	}
+
+	return value.decode(data)
+}
+
+// Write writes a string to the text file or integers to the binary
+// file and returns the number of written characters or bytes.
+func (value *File) Write(ctx Context, data Object) (Object, error) {
+	if err := value.check(false); err != nil {
+		return nil, err
+	}
+
+	var bytes []byte
+	var count int
+	if value.binary {
+		var err error
+		if bytes, err = BytesFromObject(ctx, data); err != nil {
+			return nil, err
+		}
+
+		count = len(bytes)
+	} else {
+		text, ok := data.(String)
+		if !ok {
+			return nil, NewTypeErrorf("до текстового файлу можна записати лише рядок, отримано '%s'", data.Class().Name)
+		}
+
+		bytes = []byte(text)
+		count = utf8.RuneCount(bytes)
+	}
+
+	// Data read ahead by the reader must be skipped, so the writing
+	// starts right after the last read character. Write-only streams
+	// have no reader.
+	if value.reader != nil && value.reader.Buffered() > 0 {
+		if _, err := value.file.Seek(-int64(value.reader.Buffered()), io.SeekCurrent); err != nil {
+			return nil, IOErrorFromGo(err)
+		}
+
+		value.reader.Reset(value.file)
+	}
+
+	var err error
+	if value.writer != nil {
+		_, err = value.writer.Write(bytes)
+	} else {
+		_, err = value.file.Write(bytes)
+	}
+
+	if err != nil {
+		return nil, IOErrorFromGo(err)
+	}
+
+	return Int(count), nil
+}
+
+// Flush writes the buffered data to the file, only the standard
+// output is buffered.
+func (value *File) Flush() error {
+	if value.closed || value.writer == nil {
+		return nil
+	}
+
+	if err := value.writer.Flush(); err != nil {
+		return IOErrorFromGo(err)
+	}
+
+	return nil
+}
+
+// Close closes the file, closing of the closed file has no effect. The
+// standard streams are used by the interpreter until the exit, so they
+// are only flushed and stay open.
+func (value *File) Close() error {
+	if value.closed {
+		return nil
+	}
+
+	if value == Stdin || value == Stdout || value == Stderr {
+		return value.Flush()
+	}
+
+	flushErr := value.Flush()
+	value.closed = true
+	if err := value.file.Close(); err != nil {
+		return IOErrorFromGo(err)
+	}
+
+	return flushErr
+}
+
+func (value *File) iterate(Context) (Object, error) {
+	if err := value.check(true); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *File) next(Context) (Object, error) {
+	data, err := value.readLine()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, NewStopIterationError()
+	}
+
+	return value.decode(data)
+}
@@ -0,0 +1,91 @@
+package types
+
+type fileMethodFunc func(ctx Context, self *File, args Tuple) (Object, error)
+
+// newFileMethod creates a method of 'файл' class, the first parameter
+// of which is the file itself.
+func newFileMethod(
+	pkg *Package,
+	name string,
+	parameters []MethodParameter,
+	returnType *Class,
+	f fileMethodFunc,
+) *Method {
+	return MethodNew(
+		name,
+		pkg,
+		append(
+			[]MethodParameter{
+				{
+					Class:      FileClass,
+					Classes:    nil,
+					Name:       "я",
+					IsNullable: false,
+					IsVariadic: false,
+				},
+			},
+			parameters...,
+		),
+		[]MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: returnType == NilClass,
+			},
+		},
+		func(ctx Context, args Tuple, _ StringDict) (Object, error) {
+			return f(ctx, args[0].(*File), args[1:])
+		},
+	)
+}
+
+func MakeFileClassMethods(pkg *Package) StringDict {
+	methods := []*Method{
+		newFileMethod(
+			pkg, "прочитати", nil, ObjectClass,
+			func(_ Context, self *File, _ Tuple) (Object, error) {
+				return self.Read()
+			},
+		),
+		newFileMethod(
+			pkg, "прочитати_рядок", nil, ObjectClass,
+			func(_ Context, self *File, _ Tuple) (Object, error) {
+				return self.ReadLine()
+			},
+		),
+		newFileMethod(
+			pkg, "записати", []MethodParameter{objectParameter("дані")}, IntClass,
+			func(ctx Context, self *File, args Tuple) (Object, error) {
+				return self.Write(ctx, args[0])
+			},
+		),
+		newFileMethod(
+			pkg, "закрити", nil, NilClass,
+			func(_ Context, self *File, _ Tuple) (Object, error) {
+				if err := self.Close(); err != nil {
+					return nil, err
+				}
+
+				return Nil, nil
+			},
+		),
+		newFileMethod(
+			pkg, "закритий", nil, BoolClass,
+			func(_ Context, self *File, _ Tuple) (Object, error) {
+				return gb2bo(self.closed), nil
+			},
+		),
+		newFileMethod(
+			pkg, "ім_я", nil, StringClass,
+			func(_ Context, self *File, _ Tuple) (Object, error) {
+				return String(self.Name()), nil
+			},
+		),
+	}
+
+	dict := StringDict{}
+	for _, method := range methods {
+		dict[method.Name] = method
+	}
+
+	return dict
+}
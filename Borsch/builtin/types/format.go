@@ -0,0 +1,412 @@
+package types
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// formatSpec is a parsed format specifier:
+//
+//	[[заповнювач]вирівнювання][знак][#][0][ширина][.точність][тип]
+//
+// where alignment is one of '<', '>', '^', '=', sign is one of '+', '-', ' '
+// and type is one of "bcdoxXeEfFgG%s".
+type formatSpec struct {
+	fill      rune
+	align     rune
+	sign      rune
+	alternate bool
+	width     int
+	precision int
+	kind      rune
+}
+
+func isFormatAlign(r rune) bool {
+	return r == '<' || r == '>' || r == '^' || r == '='
+}
+
+func parseFormatSpec(spec string) (*formatSpec, error) {
+	result := &formatSpec{fill: ' ', precision: -1}
+	value := []rune(spec)
+	i := 0
+	if len(value) >= 2 && isFormatAlign(value[1]) {
+		result.fill = value[0]
+		result.align = value[1]
+		i = 2
+	} else if len(value) >= 1 && isFormatAlign(value[0]) {
+		result.align = value[0]
+		i = 1
+	}
+
+	if i < len(value) && (value[i] == '+' || value[i] == '-' || value[i] == ' ') {
+		result.sign = value[i]
+		i++
+	}
+
+	if i < len(value) && value[i] == '#' {
+		result.alternate = true
+		i++
+	}
+
+	if i < len(value) && value[i] == '0' {
+		if result.align == 0 {
+			result.fill = '0'
+			result.align = '='
+		}
+
+		i++
+	}
+
+	start := i
+	for i < len(value) && value[i] >= '0' && value[i] <= '9' {
+		i++
+	}
+
+	if i > start {
+		width, err := strconv.Atoi(string(value[start:i]))
+		if err != nil {
+			return nil, NewValueErrorf("завелика ширина у специфікаторі формату '%s'", spec)
+		}
+
+		result.width = width
+	}
+
+	if i < len(value) && value[i] == '.' {
+		i++
+		start = i
+		for i < len(value) && value[i] >= '0' && value[i] <= '9' {
+			i++
+		}
+
+		if i == start {
+			return nil, NewValueErrorf("відсутня точність у специфікаторі формату '%s'", spec)
+		}
+
+		precision, err := strconv.Atoi(string(value[start:i]))
+		if err != nil {
+			return nil, NewValueErrorf("завелика точність у специфікаторі формату '%s'", spec)
+		}
+
+		result.precision = precision
+	}
+
+	if i < len(value) && strings.ContainsRune("bcdoxXeEfFgG%s", value[i]) {
+		result.kind = value[i]
+		i++
+	}
+
+	if i != len(value) {
+		return nil, NewValueErrorf("некоректний специфікатор формату '%s'", spec)
+	}
+
+	return result, nil
+}
+
+// Format converts the object to string using the format specifier.
+// An empty specifier is the same as converting the object to string.
+func Format(ctx Context, value Object, spec string) (Object, error) {
+	if spec == "" {
+		return ToString(ctx, value)
+	}
+
+	format, err := parseFormatSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var result string
+	switch value := value.(type) {
+	case Bool:
+		if format.kind == 0 || format.kind == 's' {
+			result, err = formatObject(ctx, value, format)
+		} else {
+			result, err = formatInt(bigIntFromInt(bo2io(value)), format)
+		}
+	case Int:
+		result, err = formatInt(bigIntFromInt(value), format)
+	case *BigInt:
+		result, err = formatInt(value.Big(), format)
+	case Real:
+		result, err = formatReal(value, format)
+	case *Fraction:
+		result, err = formatFraction(ctx, value, format)
+	case *Decimal:
+		result, err = formatDecimal(ctx, value, format)
+	case Complex:
+		result, err = formatComplex(ctx, value, format)
+	default:
+		result, err = formatObject(ctx, value, format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return String(result), nil
+}
+
+func formatInt(value *big.Int, format *formatSpec) (string, error) {
+	var digits, prefix string
+	abs := new(big.Int).Abs(value)
+	switch format.kind {
+	case 0, 'd':
+		digits = abs.Text(10)
+	case 'b':
+		digits, prefix = abs.Text(2), "0b"
+	case 'o':
+		digits, prefix = abs.Text(8), "0o"
+	case 'x':
+		digits, prefix = abs.Text(16), "0x"
+	case 'X':
+		digits, prefix = strings.ToUpper(abs.Text(16)), "0X"
+	case 'c':
+		if format.sign != 0 || format.alternate {
+			return "", NewValueErrorf("знак і '#' не дозволені з форматом 'c'")
+		}
+
+		if !value.IsInt64() || value.Sign() < 0 || value.Int64() > utf8.MaxRune {
+			return "", NewValueErrorf("значення %s поза межами символів Юнікоду", value)
+		}
+
+		return pad(string(rune(value.Int64())), format, '>'), nil
+	case 'e', 'E', 'f', 'F', 'g', 'G', '%':
+		realValue, err := bigIntToReal(value)
+		if err != nil {
+			return "", err
+		}
+
+		return formatReal(realValue, format)
+	default:
+		return "", NewValueErrorf(
+			"невідомий формат '%c' для об'єкта типу '%s'", format.kind, IntClass.Name,
+		)
+	}
+
+	if format.precision >= 0 {
+		return "", NewValueErrorf("точність не дозволена у форматі цілих чисел")
+	}
+
+	if !format.alternate {
+		prefix = ""
+	}
+
+	return padNumber(value.Sign() < 0, prefix, digits, format), nil
+}
+
+func formatReal(value Real, format *formatSpec) (string, error) {
+	f := float64(value)
+	precision := format.precision
+	var digits string
+	switch format.kind {
+	case 0:
+		if precision < 0 {
+			result, _ := Real(math.Abs(f)).string(nil)
+			digits = string(result.(String))
+		} else {
+			digits = strconv.FormatFloat(math.Abs(f), 'g', maxInt(precision, 1), 64)
+			if !strings.ContainsAny(digits, ".eIN") {
+				digits += ".0"
+			}
+		}
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		if precision < 0 {
+			precision = 6
+		}
+
+		if (format.kind == 'g' || format.kind == 'G') && precision == 0 {
+			precision = 1
+		}
+
+		digits = strconv.FormatFloat(math.Abs(f), byte(format.kind|0x20), precision, 64)
+		if format.kind == 'E' || format.kind == 'F' || format.kind == 'G' {
+			digits = strings.ToUpper(digits)
+		}
+	case '%':
+		if precision < 0 {
+			precision = 6
+		}
+
+		digits = strconv.FormatFloat(math.Abs(f)*100, 'f', precision, 64) + "%"
+	default:
+		return "", NewValueErrorf(
+			"невідомий формат '%c' для об'єкта типу '%s'", format.kind, RealClass.Name,
+		)
+	}
+
+	return padNumber(math.Signbit(f) && !math.IsNaN(f), "", digits, format), nil
+}
+
+func formatFraction(ctx Context, value *Fraction, format *formatSpec) (string, error) {
+	if format.kind == 0 || format.kind == 's' {
+		return formatObject(ctx, value, format)
+	}
+
+	return formatRat(value.Rat(), format, FractionClass)
+}
+
+func formatDecimal(ctx Context, value *Decimal, format *formatSpec) (string, error) {
+	switch format.kind {
+	case 's':
+		return formatObject(ctx, value, format)
+	case 0:
+		if format.precision >= 0 {
+			realValue, err := ratToReal(value.rat())
+			if err != nil {
+				return "", err
+			}
+
+			return formatReal(realValue, format)
+		}
+
+		return padNumber(value.coefficient.Sign() < 0, "", value.text(), format), nil
+	case 'f', 'F':
+		if format.precision < 0 {
+			return padNumber(value.coefficient.Sign() < 0, "", value.positional(), format), nil
+		}
+	}
+
+	return formatRat(value.rat(), format, DecimalClass)
+}
+
+// formatComplex formats both parts of the complex number as real
+// numbers and pads the result as a whole, i.e. "1.00+2.00і".
+func formatComplex(ctx Context, value Complex, format *formatSpec) (string, error) {
+	if format.kind == 's' || format.kind == 0 && format.precision < 0 {
+		return formatObject(ctx, value, format)
+	}
+
+	if format.kind == '%' {
+		return "", NewValueErrorf(
+			"невідомий формат '%c' для об'єкта типу '%s'", format.kind, ComplexClass.Name,
+		)
+	}
+
+	if format.align == '=' {
+		return "", NewValueErrorf("вирівнювання '=' не дозволене у форматі комплексного числа")
+	}
+
+	partFormat := *format
+	partFormat.width = 0
+	partFormat.align = 0
+	realPart, err := formatReal(Real(real(value)), &partFormat)
+	if err != nil {
+		return "", err
+	}
+
+	partFormat.sign = '+'
+	imagPart, err := formatReal(Real(imag(value)), &partFormat)
+	if err != nil {
+		return "", err
+	}
+
+	return pad(realPart+imagPart+"і", format, '>'), nil
+}
+
+// formatRat formats the exact number in the fixed-point notation
+// without converting it to Real, so there are no binary rounding
+// artifacts. Other notations use Real.
+func formatRat(x *big.Rat, format *formatSpec, cls *Class) (string, error) {
+	precision := format.precision
+	if precision < 0 {
+		precision = 6
+	}
+
+	switch format.kind {
+	case 'f', 'F':
+		digits := decimalFromRat(new(big.Rat).Abs(x), -precision, roundHalfEven).text()
+		return padNumber(x.Sign() < 0, "", digits, format), nil
+	case '%':
+		percents := new(big.Rat).Mul(new(big.Rat).Abs(x), big.NewRat(100, 1))
+		digits := decimalFromRat(percents, -precision, roundHalfEven).text() + "%"
+		return padNumber(x.Sign() < 0, "", digits, format), nil
+	case 'e', 'E', 'g', 'G':
+		realValue, err := ratToReal(x)
+		if err != nil {
+			return "", err
+		}
+
+		return formatReal(realValue, format)
+	default:
+		return "", NewValueErrorf(
+			"невідомий формат '%c' для об'єкта типу '%s'", format.kind, cls.Name,
+		)
+	}
+}
+
+func formatObject(ctx Context, value Object, format *formatSpec) (string, error) {
+	if format.kind != 0 && format.kind != 's' {
+		return "", NewValueErrorf(
+			"невідомий формат '%c' для об'єкта типу '%s'", format.kind, value.Class().Name,
+		)
+	}
+
+	if format.sign != 0 || format.alternate || format.align == '=' {
+		return "", NewValueErrorf("знак, '#' і вирівнювання '=' не дозволені у форматі рядка")
+	}
+
+	str, err := ToString(ctx, value)
+	if err != nil {
+		return "", err
+	}
+
+	result := string(str.(String))
+	if format.precision >= 0 && utf8.RuneCountInString(result) > format.precision {
+		result = string([]rune(result)[:format.precision])
+	}
+
+	return pad(result, format, '<'), nil
+}
+
+func padNumber(negative bool, prefix, digits string, format *formatSpec) string {
+	sign := ""
+	if negative {
+		sign = "-"
+	} else if format.sign == '+' || format.sign == ' ' {
+		sign = string(format.sign)
+	}
+
+	if format.align == '=' {
+		count := format.width - utf8.RuneCountInString(sign+prefix+digits)
+		if count > 0 {
+			digits = strings.Repeat(string(format.fill), count) + digits
+		}
+
+		return sign + prefix + digits
+	}
+
+	return pad(sign+prefix+digits, format, '>')
+}
+
+func pad(value string, format *formatSpec, defaultAlign rune) string {
+	count := format.width - utf8.RuneCountInString(value)
+	if count <= 0 {
+		return value
+	}
+
+	fill := string(format.fill)
+	align := format.align
+	if align == 0 {
+		align = defaultAlign
+	}
+
+	switch align {
+	case '<':
+		return value + strings.Repeat(fill, count)
+	case '^':
+		left := count / 2
+		return strings.Repeat(fill, left) + value + strings.Repeat(fill, count-left)
+	default:
+		return strings.Repeat(fill, count) + value
+	}
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,481 @@
+package types
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/big"
+	"strings"
+)
+
+var FractionClass = ObjectClass.ClassNew("дріб", map[string]Object{}, true, FractionNew, nil)
+
+// Fraction is an exact rational number. Fractions are immutable,
+// operations with integers and decimals give exact results, and
+// operations with real numbers give real numbers.
+type Fraction big.Rat
+
+func NewFraction(x *big.Rat) *Fraction {
+	return (*Fraction)(x)
+}
+
+func (value *Fraction) Class() *Class {
+	return FractionClass
+}
+
+// Rat returns the value as *big.Rat, which must not be modified.
+func (value *Fraction) Rat() *big.Rat {
+	return (*big.Rat)(value)
+}
+
+func FractionNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	switch len(args) {
+	case 0:
+		return NewFraction(new(big.Rat)), nil
+	case 1:
+		x, err := toRat(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return NewFraction(x), nil
+	case 2:
+		numerator, ok := ratOperand(args[0])
+		if !ok {
+			return nil, NewTypeErrorf("чисельник дробу має бути раціональним числом, отримано '%s'", args[0].Class().Name)
+		}
+
+		denominator, ok := ratOperand(args[1])
+		if !ok {
+			return nil, NewTypeErrorf("знаменник дробу має бути раціональним числом, отримано '%s'", args[1].Class().Name)
+		}
+
+		if denominator.Sign() == 0 {
+			return nil, NewZeroDivisionError("знаменник дробу дорівнює нулю")
+		}
+
+		return NewFraction(new(big.Rat).Quo(numerator, denominator)), nil
+	default:
+		return nil, NewTypeErrorf("%s() приймає не більше 2 аргументів (отримано %d)", cls.Name, len(args))
+	}
+}
+
+// FractionFromString parses fractions like "3/4", "-1.25" and "1e-3".
+func FractionFromString(str string) (Object, error) {
+	x, ok := new(big.Rat).SetString(strings.TrimSpace(str))
+	if !ok {
+		return nil, NewValueErrorf("некоректний літерал для 'дріб()': '%s'", str)
+	}
+
+	return NewFraction(x), nil
+}
+
+// ratOperand converts an exact number, i.e. Int, BigInt, Bool,
+// Fraction or Decimal, to *big.Rat.
+func ratOperand(other Object) (*big.Rat, bool) {
+	switch other := other.(type) {
+	case *Fraction:
+		return other.Rat(), true
+	case *Decimal:
+		return other.rat(), true
+	}
+
+	if x, ok := bigIntOperand(other); ok {
+		return new(big.Rat).SetInt(x), true
+	}
+
+	return nil, false
+}
+
+// toRat converts a number or a string to *big.Rat.
+func toRat(value Object) (*big.Rat, error) {
+	switch value := value.(type) {
+	case String:
+		fraction, err := FractionFromString(string(value))
+		if err != nil {
+			return nil, err
+		}
+
+		return fraction.(*Fraction).Rat(), nil
+	case Real:
+		return ratFromReal(value)
+	}
+
+	if x, ok := ratOperand(value); ok {
+		return x, nil
+	}
+
+	return nil, NewTypeErrorf("неможливо перетворити об'єкт типу '%s' у дріб", value.Class().Name)
+}
+
+func ratFromReal(value Real) (*big.Rat, error) {
+	f := float64(value)
+	if math.IsNaN(f) {
+		return nil, NewValueErrorf("неможливо перетворити NaN у раціональне число")
+	}
+
+	if math.IsInf(f, 0) {
+		return nil, NewOverflowErrorf("неможливо перетворити нескінченність у раціональне число")
+	}
+
+	return new(big.Rat).SetFloat64(f), nil
+}
+
+func ratToReal(x *big.Rat) (Real, error) {
+	f, _ := x.Float64()
+	if math.IsInf(f, 0) {
+		return 0, NewOverflowErrorf("раціональне число занадто велике, щоб перетворити його в дійсне")
+	}
+
+	return Real(f), nil
+}
+
+// compareRat compares the rational number with the number without
+// loss of precision. ok is false if the number is NaN, supported is
+// false if the object is not a number.
+func compareRat(x *big.Rat, other Object) (result int, ok bool, supported bool) {
+	if otherValue, isReal := other.(Real); isReal {
+		f := float64(otherValue)
+		switch {
+		case math.IsNaN(f):
+			return 0, false, true
+		case math.IsInf(f, 1):
+			return -1, true, true
+		case math.IsInf(f, -1):
+			return 1, true, true
+		}
+
+		return x.Cmp(new(big.Rat).SetFloat64(f)), true, true
+	}
+
+	if otherValue, isRational := ratOperand(other); isRational {
+		return x.Cmp(otherValue), true, true
+	}
+
+	return 0, false, false
+}
+
+// ratComparison compares the rational number with the number using
+// the predicate, (nil, nil) is returned if the object is not a number.
+func ratComparison(x *big.Rat, other Object, predicate func(result int, ok bool) bool) (Object, error) {
+	result, ok, supported := compareRat(x, other)
+	if !supported {
+		return nil, nil
+	}
+
+	return gb2bo(predicate(result, ok)), nil
+}
+
+// ratOrdering is ratComparison for ordering operators, which fails if
+// the object is not a number.
+func ratOrdering(
+	operator string,
+	value Object,
+	x *big.Rat,
+	other Object,
+	predicate func(result int, ok bool) bool,
+) (Object, error) {
+	if _, _, supported := compareRat(x, other); !supported {
+		return unorderable(operator, value, other)
+	}
+
+	return ratComparison(x, other, predicate)
+}
+
+// ratHash calculates a hash of the rational number, which is equal
+// to the hash of the equal integer or real number.
+func ratHash(x *big.Rat) (Object, error) {
+	if x.IsInt() {
+		return Hash(nil, newBigInt(new(big.Int).Set(x.Num())))
+	}
+
+	if f, exact := x.Float64(); exact {
+		return Real(f).hash(nil)
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(x.String()))
+	return Int(h.Sum64()), nil
+}
+
+// ratFloor returns the largest integer less than or equal to x.
+func ratFloor(x *big.Rat) *big.Int {
+	// The denominator is always positive, so Euclidean division
+	// rounds the quotient down.
+	return new(big.Int).Div(x.Num(), x.Denom())
+}
+
+func ratFunc(f func(z, a, b *big.Rat) *big.Rat) func(a, b *big.Rat) (Object, error) {
+	return func(a, b *big.Rat) (Object, error) {
+		return NewFraction(f(new(big.Rat), a, b)), nil
+	}
+}
+
+var (
+	ratAdd = ratFunc((*big.Rat).Add)
+	ratSub = ratFunc((*big.Rat).Sub)
+	ratMul = ratFunc((*big.Rat).Mul)
+)
+
+func ratDiv(a, b *big.Rat) (Object, error) {
+	if b.Sign() == 0 {
+		return nil, NewZeroDivisionError("ділення на нуль")
+	}
+
+	return NewFraction(new(big.Rat).Quo(a, b)), nil
+}
+
+func ratMod(a, b *big.Rat) (Object, error) {
+	if b.Sign() == 0 {
+		return nil, NewZeroDivisionError("цілочисельне ділення або за модулем на нуль")
+	}
+
+	quotient := new(big.Rat).SetInt(ratFloor(new(big.Rat).Quo(a, b)))
+	return NewFraction(new(big.Rat).Sub(a, quotient.Mul(quotient, b))), nil
+}
+
+// ratPow raises the rational number to the power, the result is exact
+// if the exponent is an integer and real otherwise.
+func ratPow(a, b *big.Rat) (Object, error) {
+	if !b.IsInt() {
+		base, err := ratToReal(a)
+		if err != nil {
+			return nil, err
+		}
+
+		exponent, _ := b.Float64()
+		return Real(math.Pow(float64(base), exponent)), nil
+	}
+
+	exponent := new(big.Int).Abs(b.Num())
+	if b.Sign() < 0 && a.Sign() == 0 {
+		return nil, NewZeroDivisionError("неможливо піднести 0 до від'ємного степеня")
+	}
+
+	if !exponent.IsInt64() && new(big.Rat).Abs(a).Cmp(big.NewRat(1, 1)) != 0 && a.Sign() != 0 {
+		return nil, NewOverflowErrorf("занадто великий показник степеня")
+	}
+
+	numerator := new(big.Int).Exp(a.Num(), exponent, nil)
+	denominator := new(big.Int).Exp(a.Denom(), exponent, nil)
+	if b.Sign() < 0 {
+		numerator, denominator = denominator, numerator
+	}
+
+	return NewFraction(new(big.Rat).SetFrac(numerator, denominator)), nil
+}
+
+// operation performs the binary operation with the exact operand
+// using ratOp, or with the real operand converting the value to Real
+// and using realOp. The value is the right operand if 'reversed' is true.
+// Other operands are not supported, so (nil, nil) is returned.
+func (value *Fraction) operation(
+	ctx Context,
+	other Object,
+	reversed bool,
+	ratOp func(a, b *big.Rat) (Object, error),
+	realOp func(Real, Context, Object) (Object, error),
+) (Object, error) {
+	if otherValue, ok := ratOperand(other); ok {
+		if reversed {
+			return ratOp(otherValue, value.Rat())
+		}
+
+		return ratOp(value.Rat(), otherValue)
+	}
+
+	if otherValue, ok := other.(Real); ok {
+		realValue, err := ratToReal(value.Rat())
+		if err != nil {
+			return nil, err
+		}
+
+		return realOp(realValue, ctx, otherValue)
+	}
+
+	return nil, nil
+}
+
+func (value *Fraction) represent(Context) (Object, error) {
+	return String(fmt.Sprintf("%s(%s, %s)", FractionClass.Name, value.Rat().Num(), value.Rat().Denom())), nil
+}
+
+func (value *Fraction) string(Context) (Object, error) {
+	return String(value.Rat().RatString()), nil
+}
+
+func (value *Fraction) toBool(Context) (Object, error) {
+	return gb2bo(value.Rat().Sign() != 0), nil
+}
+
+func (value *Fraction) hash(Context) (Object, error) {
+	return ratHash(value.Rat())
+}
+
+func (value *Fraction) toInt(Context) (Object, error) {
+	return newBigInt(new(big.Int).Quo(value.Rat().Num(), value.Rat().Denom())), nil
+}
+
+func (value *Fraction) toReal(Context) (Object, error) {
+	return ratToReal(value.Rat())
+}
+
+func (value *Fraction) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+func (value *Fraction) add(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, ratAdd, Real.add)
+}
+
+func (value *Fraction) reversedAdd(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, ratAdd, Real.reversedAdd)
+}
+
+func (value *Fraction) sub(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, ratSub, Real.sub)
+}
+
+func (value *Fraction) reversedSub(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, ratSub, Real.reversedSub)
+}
+
+func (value *Fraction) mul(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, ratMul, Real.mul)
+}
+
+func (value *Fraction) reversedMul(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, ratMul, Real.reversedMul)
+}
+
+func (value *Fraction) div(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, ratDiv, Real.div)
+}
+
+func (value *Fraction) reversedDiv(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, ratDiv, Real.reversedDiv)
+}
+
+func (value *Fraction) mod(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, ratMod, Real.mod)
+}
+
+func (value *Fraction) reversedMod(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, ratMod, Real.reversedMod)
+}
+
+func (value *Fraction) pow(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, false, ratPow, Real.pow)
+}
+
+func (value *Fraction) reversedPow(ctx Context, other Object) (Object, error) {
+	return value.operation(ctx, other, true, ratPow, Real.reversedPow)
+}
+
+func (value *Fraction) equals(_ Context, other Object) (Object, error) {
+	return ratComparison(
+		value.Rat(), other, func(result int, ok bool) bool {
+			return ok && result == 0
+		},
+	)
+}
+
+func (value *Fraction) notEquals(_ Context, other Object) (Object, error) {
+	return ratComparison(
+		value.Rat(), other, func(result int, ok bool) bool {
+			return !ok || result != 0
+		},
+	)
+}
+
+func (value *Fraction) less(_ Context, other Object) (Object, error) {
+	return ratOrdering(
+		"<", value, value.Rat(), other, func(result int, ok bool) bool {
+			return ok && result < 0
+		},
+	)
+}
+
+func (value *Fraction) lessOrEquals(_ Context, other Object) (Object, error) {
+	return ratOrdering(
+		"<=", value, value.Rat(), other, func(result int, ok bool) bool {
+			return ok && result <= 0
+		},
+	)
+}
+
+func (value *Fraction) greater(_ Context, other Object) (Object, error) {
+	return ratOrdering(
+		">", value, value.Rat(), other, func(result int, ok bool) bool {
+			return ok && result > 0
+		},
+	)
+}
+
+func (value *Fraction) greaterOrEquals(_ Context, other Object) (Object, error) {
+	return ratOrdering(
+		">=", value, value.Rat(), other, func(result int, ok bool) bool {
+			return ok && result >= 0
+		},
+	)
+}
+
+func (value *Fraction) positive(_ Context) (Object, error) {
+	return value, nil
+}
+
+func (value *Fraction) negate(_ Context) (Object, error) {
+	return NewFraction(new(big.Rat).Neg(value.Rat())), nil
+}
+
+func newFractionMethod(
+	pkg *Package,
+	name string,
+	returnType *Class,
+	f func(self *Fraction) Object,
+) *Method {
+	return MethodNew(
+		name,
+		pkg,
+		[]MethodParameter{
+			{
+				Class:      FractionClass,
+				Classes:    nil,
+				Name:       "я",
+				IsNullable: false,
+				IsVariadic: false,
+			},
+		},
+		[]MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: false,
+			},
+		},
+		func(_ Context, args Tuple, _ StringDict) (Object, error) {
+			return f(args[0].(*Fraction)), nil
+		},
+	)
+}
+
+func MakeFractionClassMethods(pkg *Package) StringDict {
+	methods := []*Method{
+		newFractionMethod(
+			pkg, "чисельник", IntClass, func(self *Fraction) Object {
+				return newBigInt(new(big.Int).Set(self.Rat().Num()))
+			},
+		),
+		newFractionMethod(
+			pkg, "знаменник", IntClass, func(self *Fraction) Object {
+				return newBigInt(new(big.Int).Set(self.Rat().Denom()))
+			},
+		),
+	}
+
+	dict := StringDict{}
+	for _, method := range methods {
+		dict[method.Name] = method
+	}
+
+	return dict
+}
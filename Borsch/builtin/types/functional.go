@@ -0,0 +1,249 @@
+package types
+
+import "sort"
+
+// iterators returns iterators of the iterable objects.
+func iterators(ctx Context, iterables []Object) ([]Object, error) {
+	result := make([]Object, len(iterables))
+	for i, iterable := range iterables {
+		iterator, err := Iterate(ctx, iterable)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = iterator
+	}
+
+	return result, nil
+}
+
+// nextOfAll returns next elements of all iterators, or the
+// StopIterationError as soon as one of them is exhausted.
+func nextOfAll(ctx Context, iterators []Object) (Tuple, error) {
+	elements := make(Tuple, len(iterators))
+	for i, iterator := range iterators {
+		element, err := Next(ctx, iterator)
+		if err != nil {
+			return nil, err
+		}
+
+		elements[i] = element
+	}
+
+	return elements, nil
+}
+
+// MapIterator calls the function with elements of the iterables taken
+// at the same position, it stops with the shortest iterable.
+type MapIterator struct {
+	function  Object
+	iterators []Object
+}
+
+func NewMapIterator(ctx Context, function Object, iterables []Object) (*MapIterator, error) {
+	iters, err := iterators(ctx, iterables)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MapIterator{function: function, iterators: iters}, nil
+}
+
+func (value *MapIterator) Class() *Class {
+	return IteratorClass
+}
+
+func (value *MapIterator) iterate(_ Context) (Object, error) {
+	return value, nil
+}
+
+func (value *MapIterator) next(ctx Context) (Object, error) {
+	args, err := nextOfAll(ctx, value.iterators)
+	if err != nil {
+		return nil, err
+	}
+
+	return Call(ctx, value.function, args)
+}
+
+// FilterIterator gives elements, for which the function returns
+// the true value. If the function is нуль, elements are checked
+// themselves.
+type FilterIterator struct {
+	function Object
+	iterator Object
+}
+
+func NewFilterIterator(ctx Context, function, iterable Object) (*FilterIterator, error) {
+	iterator, err := Iterate(ctx, iterable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FilterIterator{function: function, iterator: iterator}, nil
+}
+
+func (value *FilterIterator) Class() *Class {
+	return IteratorClass
+}
+
+func (value *FilterIterator) iterate(_ Context) (Object, error) {
+	return value, nil
+}
+
+func (value *FilterIterator) next(ctx Context) (Object, error) {
+	for {
+		element, err := Next(ctx, value.iterator)
+		if err != nil {
+			return nil, err
+		}
+
+		condition := element
+		if value.function != Nil {
+			if condition, err = Call(ctx, value.function, Tuple{element}); err != nil {
+				return nil, err
+			}
+		}
+
+		ok, err := ToBool(ctx, condition)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok.(Bool) {
+			return element, nil
+		}
+	}
+}
+
+// EnumerateIterator gives tuples of the number and the element.
+type EnumerateIterator struct {
+	iterator Object
+	index    Int
+}
+
+func NewEnumerateIterator(ctx Context, iterable Object, start Int) (*EnumerateIterator, error) {
+	iterator, err := Iterate(ctx, iterable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EnumerateIterator{iterator: iterator, index: start}, nil
+}
+
+func (value *EnumerateIterator) Class() *Class {
+	return IteratorClass
+}
+
+func (value *EnumerateIterator) iterate(_ Context) (Object, error) {
+	return value, nil
+}
+
+func (value *EnumerateIterator) next(ctx Context) (Object, error) {
+	element, err := Next(ctx, value.iterator)
+	if err != nil {
+		return nil, err
+	}
+
+	index := value.index
+	value.index++
+	return &Tuple{index, element}, nil
+}
+
+// ZipIterator gives tuples of elements of the iterables taken at the
+// same position, it stops with the shortest iterable.
+type ZipIterator struct {
+	iterators []Object
+}
+
+func NewZipIterator(ctx Context, iterables []Object) (*ZipIterator, error) {
+	iters, err := iterators(ctx, iterables)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ZipIterator{iterators: iters}, nil
+}
+
+func (value *ZipIterator) Class() *Class {
+	return IteratorClass
+}
+
+func (value *ZipIterator) iterate(_ Context) (Object, error) {
+	return value, nil
+}
+
+func (value *ZipIterator) next(ctx Context) (Object, error) {
+	if len(value.iterators) == 0 {
+		return nil, NewStopIterationError()
+	}
+
+	elements, err := nextOfAll(ctx, value.iterators)
+	if err != nil {
+		return nil, err
+	}
+
+	return &elements, nil
+}
+
+// Keys returns results of the key function for the elements, or the
+// elements themselves if the function is нуль.
+func Keys(ctx Context, elements []Object, key Object) ([]Object, error) {
+	if key == Nil {
+		return elements, nil
+	}
+
+	keys := make([]Object, len(elements))
+	for i, element := range elements {
+		result, err := Call(ctx, key, Tuple{element})
+		if err != nil {
+			return nil, err
+		}
+
+		keys[i] = result
+	}
+
+	return keys, nil
+}
+
+// SortByKey performs a stable sort of the elements by results of the
+// key function, which is called once for each element. Elements with
+// equal keys keep their order if the order of keys is reversed too.
+func SortByKey(ctx Context, elements []Object, key Object, reverse bool) ([]Object, error) {
+	keys, err := Keys(ctx, elements, key)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([]int, len(elements))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	sort.SliceStable(
+		indices, func(i, j int) bool {
+			if err != nil {
+				return false
+			}
+
+			a, b := keys[indices[i]], keys[indices[j]]
+			if reverse {
+				a, b = b, a
+			}
+
+			var result bool
+			result, err = goBool(ctx, Less, a, b)
+			return result
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := make([]Object, len(indices))
+	for i, index := range indices {
+		sorted[i] = elements[index]
+	}
+
+	return sorted, nil
+}
@@ -23,12 +23,56 @@ func init() {
 		nil,
 	)
 
+	IOErrorClass = ErrorClass.ClassNew("ПомилкаВводуВиводу", map[string]Object{}, false, IOErrorNew, nil)
+
+	FileNotFoundErrorClass = IOErrorClass.ClassNew(
+		"ПомилкаФайлНеЗнайдено",
+		map[string]Object{},
+		false,
+		IOErrorNew,
+		nil,
+	)
+
+	FileExistsErrorClass = IOErrorClass.ClassNew("ПомилкаФайлІснує", map[string]Object{}, false, IOErrorNew, nil)
+
+	PermissionErrorClass = IOErrorClass.ClassNew("ПомилкаДоступу", map[string]Object{}, false, IOErrorNew, nil)
+
+	IsADirectoryErrorClass = IOErrorClass.ClassNew("ПомилкаЦеКаталог", map[string]Object{}, false, IOErrorNew, nil)
+
+	NotADirectoryErrorClass = IOErrorClass.ClassNew("ПомилкаНеКаталог", map[string]Object{}, false, IOErrorNew, nil)
+
+	DirectoryNotEmptyErrorClass = IOErrorClass.ClassNew(
+		"ПомилкаКаталогНеПорожній",
+		map[string]Object{},
+		false,
+		IOErrorNew,
+		nil,
+	)
+
+	KeyErrorClass = ErrorClass.ClassNew("ПомилкаКлюча", map[string]Object{}, false, KeyErrorNew, nil)
+
 	RuntimeErrorClass = ErrorClass.ClassNew("ПомилкаВиконання", map[string]Object{}, false, RuntimeErrorNew, nil)
 
+	StopIterationErrorClass = ErrorClass.ClassNew(
+		"ЗупинкаІтерації",
+		map[string]Object{},
+		false,
+		StopIterationErrorNew,
+		nil,
+	)
+
 	TypeErrorClass = ErrorClass.ClassNew("ПомилкаТипу", map[string]Object{}, false, TypeErrorNew, nil)
 
 	ValueErrorClass = ErrorClass.ClassNew("ПомилкаЗначення", map[string]Object{}, false, ValueErrorNew, nil)
 
+	OverflowErrorClass = ErrorClass.ClassNew(
+		"ПомилкаПереповнення",
+		map[string]Object{},
+		false,
+		OverflowErrorNew,
+		nil,
+	)
+
 	ZeroDivisionErrorClass = ErrorClass.ClassNew(
 		"ПомилкаДіленняНаНуль",
 		map[string]Object{},
@@ -36,6 +80,4 @@ func init() {
 		ZeroDivisionErrorNew,
 		nil,
 	)
-
-	overflowErrorGo = NewErrorf("ціле число занадто велике, щоб перетворити його в Go int")
 }
@@ -142,9 +142,7 @@ func IntFromString(str string, base int) (Object, error) {
 		x.Neg(x)
 	}
 
-	// TODO:
-	// return (*BigInt)(x).MaybeInt(), nil
-	return nil, NewErrorf("overflow...")
+	return (*BigInt)(x).MaybeInt(), nil
 
 error:
 	// TODO: ValueError
@@ -163,6 +161,10 @@ func (value Int) toBool(Context) (Object, error) {
 	return Bool(value != 0), nil
 }
 
+func (value Int) hash(Context) (Object, error) {
+	return value, nil
+}
+
 func (value Int) toReal(Context) (Object, error) {
 	return Real(value), nil
 }
@@ -177,15 +179,19 @@ func (value Int) toReal(Context) (Object, error) {
 func (value Int) toGoInt(Context) (int, error) {
 	r := int(value)
 	if Int(r) != value {
-		return 0, overflowErrorGo
+		return 0, NewOverflowErrorf("ціле число занадто велике, щоб перетворити його в Go int")
 	}
 
 	return r, nil
 }
 
-func (value Int) add(_ Context, other Object) (Object, error) {
+func (value Int) add(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedAdd(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
-		return value + otherValue, nil
+		return addInt(value, otherValue), nil
 	}
 
 	if otherValue, ok := other.(Real); ok {
@@ -193,15 +199,19 @@ func (value Int) add(_ Context, other Object) (Object, error) {
 	}
 
 	if otherValue, ok := other.(Bool); ok {
-		return value + bo2io(otherValue), nil
+		return addInt(value, bo2io(otherValue)), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати додавання цілого числа до об'єкта '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) reversedAdd(_ Context, other Object) (Object, error) {
+func (value Int) reversedAdd(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.add(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
-		return otherValue + value, nil
+		return addInt(otherValue, value), nil
 	}
 
 	if otherValue, ok := other.(Real); ok {
@@ -209,15 +219,19 @@ func (value Int) reversedAdd(_ Context, other Object) (Object, error) {
 	}
 
 	if otherValue, ok := other.(Bool); ok {
-		return bo2io(otherValue) + value, nil
+		return addInt(bo2io(otherValue), value), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати додавання об'єкта '%s' до ціле число", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) sub(_ Context, other Object) (Object, error) {
+func (value Int) sub(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedSub(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
-		return value - otherValue, nil
+		return subInt(value, otherValue), nil
 	}
 
 	if otherValue, ok := other.(Real); ok {
@@ -225,15 +239,19 @@ func (value Int) sub(_ Context, other Object) (Object, error) {
 	}
 
 	if otherValue, ok := other.(Bool); ok {
-		return value - bo2io(otherValue), nil
+		return subInt(value, bo2io(otherValue)), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати віднімання цілого числа від об'єкта '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) reversedSub(_ Context, other Object) (Object, error) {
+func (value Int) reversedSub(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.sub(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
-		return otherValue - value, nil
+		return subInt(otherValue, value), nil
 	}
 
 	if otherValue, ok := other.(Real); ok {
@@ -241,13 +259,17 @@ func (value Int) reversedSub(_ Context, other Object) (Object, error) {
 	}
 
 	if otherValue, ok := other.(Bool); ok {
-		return bo2io(otherValue) - value, nil
+		return subInt(bo2io(otherValue), value), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати віднімання об'єкта '%s' від цілого числа", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) div(_ Context, other Object) (Object, error) {
+func (value Int) div(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedDiv(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
 		if otherValue == 0 {
 			return nil, NewZeroDivisionError("ділення на нуль")
@@ -272,10 +294,14 @@ func (value Int) div(_ Context, other Object) (Object, error) {
 		return value, nil
 	}
 
-	return nil, NewErrorf("неможливо виконати ділення цілого числа на об'єкт '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) reversedDiv(_ Context, other Object) (Object, error) {
+func (value Int) reversedDiv(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.div(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
 		if value == 0 {
 			return nil, NewZeroDivisionError("ділення на нуль")
@@ -304,12 +330,16 @@ func (value Int) reversedDiv(_ Context, other Object) (Object, error) {
 		return 1.0 / Real(value), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати ділення об'єкта '%s' на ціле число", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) mul(_ Context, other Object) (Object, error) {
+func (value Int) mul(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedMul(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
-		return value * otherValue, nil
+		return mulInt(value, otherValue), nil
 	}
 
 	if otherValue, ok := other.(Real); ok {
@@ -329,19 +359,28 @@ func (value Int) mul(_ Context, other Object) (Object, error) {
 		return result, nil
 	}
 
+	if otherValue, ok := other.(*Tuple); ok {
+		return otherValue.mul(ctx, value)
+	}
+
+	if otherValue, ok := other.(*List); ok {
+		return otherValue.mul(ctx, value)
+	}
+
 	if otherValue, ok := other.(Bool); ok {
 		return value * bo2io(otherValue), nil
 	}
 
-	// TODO: add multiplication for:
-	//  int, ...
-
-	return nil, NewErrorf("неможливо виконати множення цілого числа на об'єкт '%s'", other.Class().Name)
+	return nil, nil
 }
 
 func (value Int) reversedMul(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.mul(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
-		return otherValue * value, nil
+		return mulInt(otherValue, value), nil
 	}
 
 	if otherValue, ok := other.(Real); ok {
@@ -360,13 +399,14 @@ func (value Int) reversedMul(ctx Context, other Object) (Object, error) {
 		return value, nil
 	}
 
-	// TODO: add multiplication for:
-	//  ..., int
-
-	return nil, NewErrorf("неможливо виконати множення об'єкта '%s' на ціле число", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) mod(_ Context, other Object) (Object, error) {
+func (value Int) mod(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedMod(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
 		if otherValue == 0 {
 			return nil, NewZeroDivisionError("цілочисельне ділення або за модулем на нуль")
@@ -391,10 +431,14 @@ func (value Int) mod(_ Context, other Object) (Object, error) {
 		return Int(mod(Real(value), bo2ro(otherValue))), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати модуль? цілого числа  '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) reversedMod(_ Context, other Object) (Object, error) {
+func (value Int) reversedMod(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.mod(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
 		if value == 0 {
 			return nil, NewZeroDivisionError("цілочисельне ділення або за модулем на нуль")
@@ -419,74 +463,38 @@ func (value Int) reversedMod(_ Context, other Object) (Object, error) {
 		return Int(mod(bo2ro(otherValue), Real(value))), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати модуль? об'єкта '%s'  ціле число", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) pow(_ Context, other Object) (Object, error) {
-	if otherValue, ok := other.(Int); ok {
-		result := math.Pow(float64(value), float64(otherValue))
-		if otherValue < 0 {
-			return Real(result), nil
-		}
-
-		return Int(result), nil
+func (value Int) pow(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := bigIntOperand(other); ok {
+		return powBigInt(bigIntFromInt(value), otherValue)
 	}
 
 	if otherValue, ok := other.(Real); ok {
 		return Real(math.Pow(float64(value), float64(otherValue))), nil
 	}
 
-	if otherValue, ok := other.(Bool); ok {
-		if otherValue {
-			return value, nil
-		}
-
-		return Int(1), nil
-	}
-
-	// TODO:
-	return nil, NewErrorf("неможливо виконати ??? '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) reversedPow(_ Context, other Object) (Object, error) {
-	if otherValue, ok := other.(Int); ok {
-		result := math.Pow(float64(otherValue), float64(value))
-		if otherValue < 0 {
-			return Real(result), nil
-		}
-
-		return Int(result), nil
+func (value Int) reversedPow(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := bigIntOperand(other); ok {
+		return powBigInt(otherValue, bigIntFromInt(value))
 	}
 
 	if otherValue, ok := other.(Real); ok {
 		return Real(math.Pow(float64(otherValue), float64(value))), nil
 	}
 
-	if otherValue, ok := other.(Bool); ok {
-		if otherValue {
-			if value < 0 {
-				return Real(1.0), nil
-			}
-
-			return Int(1), nil
-		}
-
-		if value < 0.0 {
-			return nil, NewZeroDivisionError("неможливо піднести 0.0 до від'ємного степеня")
-		}
-
-		if value == 0 {
-			return Int(1), nil
-		}
+	return nil, nil
+}
 
-		return Int(0), nil
+func (value Int) equals(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.equals(ctx, value)
 	}
 
-	// TODO:
-	return nil, NewErrorf("неможливо виконати ??? '%s' ???", other.Class().Name)
-}
-
-func (value Int) equals(_ Context, other Object) (Object, error) {
 	if v, ok := other.(Int); ok {
 		return gb2bo(value == v), nil
 	}
@@ -499,10 +507,14 @@ func (value Int) equals(_ Context, other Object) (Object, error) {
 		return gb2bo(value == bo2io(v)), nil
 	}
 
-	return False, nil
+	return nil, nil
 }
 
-func (value Int) notEquals(_ Context, other Object) (Object, error) {
+func (value Int) notEquals(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.notEquals(ctx, value)
+	}
+
 	if v, ok := other.(Int); ok {
 		return goBoolToBoolObject(value != v), nil
 	}
@@ -515,10 +527,14 @@ func (value Int) notEquals(_ Context, other Object) (Object, error) {
 		return gb2bo(value != bo2io(v)), nil
 	}
 
-	return False, nil
+	return nil, nil
 }
 
-func (value Int) less(_ Context, other Object) (Object, error) {
+func (value Int) less(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.greater(ctx, value)
+	}
+
 	if v, ok := other.(Int); ok {
 		return goBoolToBoolObject(value < v), nil
 	}
@@ -531,10 +547,14 @@ func (value Int) less(_ Context, other Object) (Object, error) {
 		return gb2bo(value < bo2io(v)), nil
 	}
 
-	return nil, OperatorNotSupportedErrorNew("<", value.Class().Name, other.Class().Name)
+	return unorderable("<", value, other)
 }
 
-func (value Int) lessOrEquals(_ Context, other Object) (Object, error) {
+func (value Int) lessOrEquals(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.greaterOrEquals(ctx, value)
+	}
+
 	if v, ok := other.(Int); ok {
 		return goBoolToBoolObject(value <= v), nil
 	}
@@ -547,10 +567,14 @@ func (value Int) lessOrEquals(_ Context, other Object) (Object, error) {
 		return gb2bo(value <= bo2io(v)), nil
 	}
 
-	return nil, OperatorNotSupportedErrorNew("<=", value.Class().Name, other.Class().Name)
+	return unorderable("<=", value, other)
 }
 
-func (value Int) greater(_ Context, other Object) (Object, error) {
+func (value Int) greater(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.less(ctx, value)
+	}
+
 	if v, ok := other.(Int); ok {
 		return goBoolToBoolObject(value > v), nil
 	}
@@ -563,10 +587,14 @@ func (value Int) greater(_ Context, other Object) (Object, error) {
 		return gb2bo(value > bo2io(v)), nil
 	}
 
-	return nil, OperatorNotSupportedErrorNew(">", value.Class().Name, other.Class().Name)
+	return unorderable(">", value, other)
 }
 
-func (value Int) greaterOrEquals(_ Context, other Object) (Object, error) {
+func (value Int) greaterOrEquals(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.lessOrEquals(ctx, value)
+	}
+
 	if v, ok := other.(Int); ok {
 		return goBoolToBoolObject(value >= v), nil
 	}
@@ -579,58 +607,78 @@ func (value Int) greaterOrEquals(_ Context, other Object) (Object, error) {
 		return gb2bo(value >= bo2io(v)), nil
 	}
 
-	return nil, OperatorNotSupportedErrorNew(">=", value.Class().Name, other.Class().Name)
+	return unorderable(">=", value, other)
 }
 
-func (value Int) shiftLeft(_ Context, other Object) (Object, error) {
+func (value Int) shiftLeft(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedShiftLeft(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
-		return value << otherValue, nil
+		return shiftLeftInt(value, otherValue)
 	}
 
 	if otherValue, ok := other.(Bool); ok {
-		return value << bo2io(otherValue), nil
+		return shiftLeftInt(value, bo2io(otherValue))
 	}
 
-	return nil, NewErrorf("неможливо виконати побітовий зсув ліворуч цілого числа на об'єкт '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) reversedShiftLeft(_ Context, other Object) (Object, error) {
+func (value Int) reversedShiftLeft(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.shiftLeft(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
-		return otherValue << value, nil
+		return shiftLeftInt(otherValue, value)
 	}
 
 	if otherValue, ok := other.(Bool); ok {
-		return bo2io(otherValue) << value, nil
+		return shiftLeftInt(bo2io(otherValue), value)
 	}
 
-	return nil, NewErrorf("неможливо виконати побітовий зсув ліворуч об'єкта '%s' на ціле число", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) shiftRight(_ Context, other Object) (Object, error) {
+func (value Int) shiftRight(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedShiftRight(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
-		return value >> otherValue, nil
+		return shiftRightInt(value, otherValue)
 	}
 
 	if otherValue, ok := other.(Bool); ok {
-		return value >> bo2io(otherValue), nil
+		return shiftRightInt(value, bo2io(otherValue))
 	}
 
-	return nil, NewErrorf("неможливо виконати побітовий зсув праворуч цілого числа на об'єкт '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) reversedShiftRight(_ Context, other Object) (Object, error) {
+func (value Int) reversedShiftRight(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.shiftRight(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
-		return otherValue >> value, nil
+		return shiftRightInt(otherValue, value)
 	}
 
 	if otherValue, ok := other.(Bool); ok {
-		return bo2io(otherValue) >> value, nil
+		return shiftRightInt(bo2io(otherValue), value)
 	}
 
-	return nil, NewErrorf("неможливо виконати побітовий зсув праворуч об'єкта '%s' на ціле число", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) bitwiseOr(_ Context, other Object) (Object, error) {
+func (value Int) bitwiseOr(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedBitwiseOr(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
 		return value | otherValue, nil
 	}
@@ -639,10 +687,14 @@ func (value Int) bitwiseOr(_ Context, other Object) (Object, error) {
 		return value | bo2io(otherValue), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати побітову диз'юнкцію цілого числа та об'єкта '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) reversedBitwiseOr(_ Context, other Object) (Object, error) {
+func (value Int) reversedBitwiseOr(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.bitwiseOr(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
 		return otherValue | value, nil
 	}
@@ -651,10 +703,14 @@ func (value Int) reversedBitwiseOr(_ Context, other Object) (Object, error) {
 		return bo2io(otherValue) | value, nil
 	}
 
-	return nil, NewErrorf("неможливо виконати побітову диз'юнкцію об'єкта '%s' та ціле число", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) bitwiseXor(_ Context, other Object) (Object, error) {
+func (value Int) bitwiseXor(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedBitwiseXor(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
 		return value ^ otherValue, nil
 	}
@@ -663,13 +719,14 @@ func (value Int) bitwiseXor(_ Context, other Object) (Object, error) {
 		return value ^ bo2io(otherValue), nil
 	}
 
-	return nil, NewErrorf(
-		"неможливо виконати побітову виняткову диз'юнкцію цілого числа та об'єкта '%s'",
-		other.Class().Name,
-	)
+	return nil, nil
 }
 
-func (value Int) reversedBitwiseXor(_ Context, other Object) (Object, error) {
+func (value Int) reversedBitwiseXor(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.bitwiseXor(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
 		return otherValue ^ value, nil
 	}
@@ -678,13 +735,14 @@ func (value Int) reversedBitwiseXor(_ Context, other Object) (Object, error) {
 		return bo2io(otherValue) ^ value, nil
 	}
 
-	return nil, NewErrorf(
-		"неможливо виконати побітову виняткову диз'юнкцію об'єкта '%s' та ціле число",
-		other.Class().Name,
-	)
+	return nil, nil
 }
 
-func (value Int) bitwiseAnd(_ Context, other Object) (Object, error) {
+func (value Int) bitwiseAnd(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedBitwiseAnd(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
 		return value & otherValue, nil
 	}
@@ -693,10 +751,14 @@ func (value Int) bitwiseAnd(_ Context, other Object) (Object, error) {
 		return value & bo2io(otherValue), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати побітову кон'юнкцію цілого числа та об'єкта '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Int) reversedBitwiseAnd(_ Context, other Object) (Object, error) {
+func (value Int) reversedBitwiseAnd(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.bitwiseAnd(ctx, value)
+	}
+
 	if otherValue, ok := other.(Int); ok {
 		return otherValue & value, nil
 	}
@@ -705,7 +767,7 @@ func (value Int) reversedBitwiseAnd(_ Context, other Object) (Object, error) {
 		return bo2io(otherValue) & value, nil
 	}
 
-	return nil, NewErrorf("неможливо виконати побітову кон'юнкцію об'єкта '%s' та ціле число", other.Class().Name)
+	return nil, nil
 }
 
 func (value Int) positive(_ Context) (Object, error) {
@@ -713,7 +775,7 @@ func (value Int) positive(_ Context) (Object, error) {
 }
 
 func (value Int) negate(_ Context) (Object, error) {
-	return -value, nil
+	return negateInt(value), nil
 }
 
 func (value Int) invert(_ Context) (Object, error) {
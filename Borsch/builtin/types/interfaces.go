@@ -4,6 +4,11 @@ type Object interface {
 	Class() *Class
 }
 
+// ISized is a container, the number of elements of which is known.
+type ISized interface {
+	Length(ctx Context) (Int, error)
+}
+
 type ISequence interface {
 	Length(ctx Context) (Int, error)
 	GetElement(ctx Context, index Int) (Object, error)
@@ -11,6 +16,17 @@ type ISequence interface {
 	Slice(ctx Context, lBound Int, rBound Int) (Object, error)
 }
 
+type ISliceAssignment interface {
+	SetSlice(ctx Context, lBound Int, rBound Int, items Object) (Object, error)
+}
+
+type IMapping interface {
+	Length(ctx Context) (Int, error)
+	GetItem(ctx Context, key Object) (Object, error)
+	SetItem(ctx Context, key Object, item Object) (Object, error)
+	DeleteItem(ctx Context, key Object) (Object, error)
+}
+
 type IString interface {
 	string(ctx Context) (Object, error)
 }
@@ -35,6 +51,30 @@ type ILength interface {
 	length(ctx Context) (Object, error)
 }
 
+type IHash interface {
+	hash(ctx Context) (Object, error)
+}
+
+type IContains interface {
+	contains(ctx Context, item Object) (Object, error)
+}
+
+type IIterate interface {
+	iterate(ctx Context) (Object, error)
+}
+
+type INext interface {
+	next(ctx Context) (Object, error)
+}
+
+type ICopy interface {
+	copy(ctx Context) (Object, error)
+}
+
+type IDeepCopy interface {
+	deepCopy(ctx Context, memo map[Object]Object) (Object, error)
+}
+
 type IGoInt interface {
 	toGoInt(ctx Context) (int, error)
 }
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"syscall"
+)
+
+var (
+	IOErrorClass *Class
+
+	FileNotFoundErrorClass      *Class
+	FileExistsErrorClass        *Class
+	PermissionErrorClass        *Class
+	IsADirectoryErrorClass      *Class
+	NotADirectoryErrorClass     *Class
+	DirectoryNotEmptyErrorClass *Class
+)
+
+// IOError is an error of the input/output operation. The same type
+// is used for all classes of the hierarchy, i.e. the file is not found
+// or the permission is denied.
+type IOError struct {
+	message string
+	class   *Class
+}
+
+func (value *IOError) Error() string {
+	return fmt.Sprintf("%s: %s", value.Class().Name, value.message)
+}
+
+func (value *IOError) Class() *Class {
+	return value.class
+}
+
+func IOErrorNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	message, err := errorMessageFromArgs(ctx, cls, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IOError{message: message, class: cls}, nil
+}
+
+func NewIOError(text string) *IOError {
+	return &IOError{message: text, class: IOErrorClass}
+}
+
+func NewIOErrorf(format string, args ...interface{}) *IOError {
+	return &IOError{message: fmt.Sprintf(format, args...), class: IOErrorClass}
+}
+
+// IOErrorFromGo converts the error of the Go 'os' package to IOError
+// of the most specific class.
+func IOErrorFromGo(err error) *IOError {
+	var pathErr *fs.PathError
+	var linkErr *os.LinkError
+	location := ""
+	description := err.Error()
+	switch {
+	case errors.As(err, &linkErr):
+		location = fmt.Sprintf(": '%s' -> '%s'", linkErr.Old, linkErr.New)
+		description = linkErr.Err.Error()
+	case errors.As(err, &pathErr):
+		location = fmt.Sprintf(": '%s'", pathErr.Path)
+		description = pathErr.Err.Error()
+	}
+
+	// Specific errors are checked first, because i.e. ENOTEMPTY
+	// is also reported as fs.ErrExist.
+	class := IOErrorClass
+	switch {
+	case errors.Is(err, syscall.ENOTEMPTY):
+		class, description = DirectoryNotEmptyErrorClass, "каталог не порожній"
+	case errors.Is(err, syscall.EISDIR):
+		class, description = IsADirectoryErrorClass, "шлях є каталогом"
+	case errors.Is(err, syscall.ENOTDIR):
+		class, description = NotADirectoryErrorClass, "шлях не є каталогом"
+	case errors.Is(err, fs.ErrNotExist):
+		class, description = FileNotFoundErrorClass, "файл або каталог не існує"
+	case errors.Is(err, fs.ErrExist):
+		class, description = FileExistsErrorClass, "файл або каталог вже існує"
+	case errors.Is(err, fs.ErrPermission):
+		class, description = PermissionErrorClass, "доступ заборонено"
+	}
+
+	return &IOError{message: description + location, class: class}
+}
+
+func (value *IOError) represent(ctx Context) (Object, error) {
+	return value.string(ctx)
+}
+
+func (value *IOError) string(_ Context) (Object, error) {
+	return String(value.message), nil
+}
@@ -0,0 +1,68 @@
+package types
+
+var IteratorClass = ObjectClass.ClassNew("ітератор", map[string]Object{}, true, nil, nil)
+
+// SequenceIterator iterates over elements of any ISequence
+// by their indices.
+type SequenceIterator struct {
+	sequence ISequence
+	index    Int
+}
+
+func NewSequenceIterator(sequence ISequence) *SequenceIterator {
+	return &SequenceIterator{sequence: sequence, index: 0}
+}
+
+func (value *SequenceIterator) Class() *Class {
+	return IteratorClass
+}
+
+func (value *SequenceIterator) iterate(_ Context) (Object, error) {
+	return value, nil
+}
+
+func (value *SequenceIterator) next(ctx Context) (Object, error) {
+	// The length is checked on each step, because the sequence
+	// can be changed during the iteration.
+	length, err := value.sequence.Length(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if value.index >= length {
+		return nil, NewStopIterationError()
+	}
+
+	element, err := value.sequence.GetElement(ctx, value.index)
+	if err != nil {
+		return nil, err
+	}
+
+	value.index++
+	return element, nil
+}
+
+// sliceIterator iterates over a snapshot of elements, it is used
+// by containers which are not sequences, i.e. dictionaries.
+type sliceIterator struct {
+	elements []Object
+	index    int
+}
+
+func (value *sliceIterator) Class() *Class {
+	return IteratorClass
+}
+
+func (value *sliceIterator) iterate(_ Context) (Object, error) {
+	return value, nil
+}
+
+func (value *sliceIterator) next(_ Context) (Object, error) {
+	if value.index >= len(value.elements) {
+		return nil, NewStopIterationError()
+	}
+
+	element := value.elements[value.index]
+	value.index++
+	return element, nil
+}
@@ -0,0 +1,42 @@
+package types
+
+import "fmt"
+
+var KeyErrorClass *Class
+
+type KeyError struct {
+	message string
+}
+
+func (value *KeyError) Error() string {
+	return fmt.Sprintf("%s: %s", value.Class().Name, value.message)
+}
+
+func (value *KeyError) Class() *Class {
+	return KeyErrorClass
+}
+
+func KeyErrorNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	message, err := errorMessageFromArgs(ctx, cls, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyError{message: message}, nil
+}
+
+func NewKeyError(text string) *KeyError {
+	return &KeyError{message: text}
+}
+
+func NewKeyErrorf(format string, args ...interface{}) *KeyError {
+	return &KeyError{message: fmt.Sprintf(format, args...)}
+}
+
+func (value *KeyError) represent(ctx Context) (Object, error) {
+	return value.string(ctx)
+}
+
+func (value *KeyError) string(_ Context) (Object, error) {
+	return String(value.message), nil
+}
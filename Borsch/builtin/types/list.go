@@ -25,7 +25,31 @@ func (value *List) Class() *Class {
 }
 
 func ListNew(ctx Context, cls *Class, args Tuple) (Object, error) {
-	return &List{Values: args}, nil
+	if len(args) != 1 {
+		return &List{Values: args}, nil
+	}
+
+	list := &List{}
+	switch arg := args[0].(type) {
+	case *List:
+		list.Values = make([]Object, len(arg.Values))
+		copy(list.Values, arg.Values)
+	case *Tuple:
+		list.Values = make([]Object, len(*arg))
+		copy(list.Values, *arg)
+	default:
+		err := IterateOver(
+			ctx, arg, func(element Object) (bool, error) {
+				list.Values = append(list.Values, element)
+				return false, nil
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return list, nil
 }
 
 func (value *List) represent(ctx Context) (Object, error) {
@@ -33,21 +57,149 @@ func (value *List) represent(ctx Context) (Object, error) {
 }
 
 func (value *List) string(ctx Context) (Object, error) {
-	str := String("")
-	vLen := len(value.Values)
-	for i, item := range value.Values {
-		itemStr, err := Represent(ctx, item)
+	return representContainer(
+		value, "[...]", func() (Object, error) {
+			str := String("")
+			vLen := len(value.Values)
+			for i, item := range value.Values {
+				itemStr, err := Represent(ctx, item)
+				if err != nil {
+					return nil, err
+				}
+
+				str += itemStr.(String)
+				if i < vLen-1 {
+					str += ", "
+				}
+			}
+
+			return String(fmt.Sprintf("[%s]", str)), nil
+		},
+	)
+}
+
+func (value *List) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+func (value *List) add(_ Context, other Object) (Object, error) {
+	if l, ok := other.(*List); ok {
+		list := NewList()
+		list.Values = make([]Object, 0, len(value.Values)+len(l.Values))
+		list.Values = append(list.Values, value.Values...)
+		list.Values = append(list.Values, l.Values...)
+		return list, nil
+	}
+
+	return nil, NewErrorf("неможливо виконати конкатенацію списку з об'єктом '%s'", other.Class().Name)
+}
+
+func (value *List) mul(_ Context, other Object) (Object, error) {
+	var count Int
+	switch otherValue := other.(type) {
+	case Int:
+		count = otherValue
+	case Bool:
+		count = bo2io(otherValue)
+	default:
+		return nil, NewErrorf("неможливо виконати множення списку на об'єкт '%s'", other.Class().Name)
+	}
+
+	list := NewList()
+	for i := Int(0); i < count; i++ {
+		list.Values = append(list.Values, value.Values...)
+	}
+
+	return list, nil
+}
+
+func (value *List) reversedMul(ctx Context, other Object) (Object, error) {
+	return value.mul(ctx, other)
+}
+
+func (value *List) equals(ctx Context, other Object) (Object, error) {
+	if l, ok := other.(*List); ok {
+		result, err := value.compareWith(ctx, l, true)
 		if err != nil {
 			return nil, err
 		}
 
-		str += itemStr.(String)
-		if i < vLen-1 {
-			str += ", "
+		return gb2bo(result == 0), nil
+	}
+
+	return False, nil
+}
+
+func (value *List) notEquals(ctx Context, other Object) (Object, error) {
+	if l, ok := other.(*List); ok {
+		result, err := value.compareWith(ctx, l, true)
+		if err != nil {
+			return nil, err
+		}
+
+		return gb2bo(result != 0), nil
+	}
+
+	return True, nil
+}
+
+func (value *List) less(ctx Context, other Object) (Object, error) {
+	return value.compare(ctx, other, "<", func(result int) bool { return result < 0 })
+}
+
+func (value *List) lessOrEquals(ctx Context, other Object) (Object, error) {
+	return value.compare(ctx, other, "<=", func(result int) bool { return result <= 0 })
+}
+
+func (value *List) greater(ctx Context, other Object) (Object, error) {
+	return value.compare(ctx, other, ">", func(result int) bool { return result > 0 })
+}
+
+func (value *List) greaterOrEquals(ctx Context, other Object) (Object, error) {
+	return value.compare(ctx, other, ">=", func(result int) bool { return result >= 0 })
+}
+
+func (value *List) compare(ctx Context, other Object, operator string, check func(int) bool) (Object, error) {
+	if l, ok := other.(*List); ok {
+		result, err := value.compareWith(ctx, l, false)
+		if err != nil {
+			return nil, err
 		}
+
+		return gb2bo(check(result)), nil
 	}
 
-	return String(fmt.Sprintf("[%s]", str)), nil
+	return nil, OperatorNotSupportedErrorNew(operator, value.Class().Name, other.Class().Name)
+}
+
+func (value *List) compareWith(ctx Context, other *List, equalityOnly bool) (int, error) {
+	return compareContainers(
+		value, other, func() (int, error) {
+			return compareSequences(ctx, value.Values, other.Values, equalityOnly)
+		},
+	)
+}
+
+func (value *List) copy(_ Context) (Object, error) {
+	list := NewList()
+	list.Values = make([]Object, len(value.Values))
+	copy(list.Values, value.Values)
+	return list, nil
+}
+
+func (value *List) deepCopy(ctx Context, memo map[Object]Object) (Object, error) {
+	list := NewList()
+	memo[value] = list
+	list.Values = make([]Object, len(value.Values))
+	if err := deepCopyElements(ctx, list.Values, value.Values, memo); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (value *List) contains(ctx Context, item Object) (Object, error) {
+	return containsElement(ctx, value.Values, item)
 }
 
 func (value *List) Length(_ Context) (Int, error) {
@@ -109,3 +261,36 @@ func (value *List) Slice(ctx Context, leftBound, rightBound Int) (Object, error)
 	copy(list.Values, slicedList)
 	return list, nil
 }
+
+func (value *List) SetSlice(ctx Context, leftBound, rightBound Int, items Object) (Object, error) {
+	var elements []Object
+	err := IterateOver(
+		ctx, items, func(element Object) (bool, error) {
+			elements = append(elements, element)
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	length := Int(len(value.Values))
+	if leftBound < 0 {
+		leftBound = 0
+	} else if leftBound > length {
+		leftBound = length
+	}
+
+	if rightBound > length {
+		rightBound = length
+	} else if rightBound < leftBound {
+		rightBound = leftBound
+	}
+
+	values := make([]Object, 0, len(value.Values)-int(rightBound-leftBound)+len(elements))
+	values = append(values, value.Values[:leftBound]...)
+	values = append(values, elements...)
+	values = append(values, value.Values[rightBound:]...)
+	value.Values = values
+	return value, nil
+}
@@ -0,0 +1,319 @@
+package types
+
+import "sort"
+
+type listMethodFunc func(ctx Context, self *List, args Tuple) (Object, error)
+
+// newListMethod creates a method of 'список' class, the first
+// parameter of which is the list itself.
+func newListMethod(
+	pkg *Package,
+	name string,
+	parameters []MethodParameter,
+	returnType *Class,
+	f listMethodFunc,
+) *Method {
+	return MethodNew(
+		name,
+		pkg,
+		append(
+			[]MethodParameter{
+				{
+					Class:      ListClass,
+					Classes:    nil,
+					Name:       "я",
+					IsNullable: false,
+					IsVariadic: false,
+				},
+			},
+			parameters...,
+		),
+		[]MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: returnType == NilClass,
+			},
+		},
+		func(ctx Context, args Tuple, _ StringDict) (Object, error) {
+			return f(ctx, args[0].(*List), args[1:])
+		},
+	)
+}
+
+func objectParameter(name string) MethodParameter {
+	return MethodParameter{
+		Class:      ObjectClass,
+		Classes:    nil,
+		Name:       name,
+		IsNullable: true,
+		IsVariadic: false,
+	}
+}
+
+// normalizeIndex converts the negative index to the index from
+// the beginning of the list and checks whether it is in range.
+func (value *List) normalizeIndex(index Int) (Int, error) {
+	length := Int(len(value.Values))
+	if index < 0 {
+		index += length
+	}
+
+	if err := checkIndex(index, length, "списку"); err != nil {
+		return 0, err
+	}
+
+	return index, nil
+}
+
+// indexOf returns the index of the first element which equals
+// to the item, or -1 if there is no such element.
+func (value *List) indexOf(ctx Context, item Object) (int, error) {
+	for i, element := range value.Values {
+		equals, err := goBool(ctx, Equals, element, item)
+		if err != nil {
+			return 0, err
+		}
+
+		if equals {
+			return i, nil
+		}
+	}
+
+	return -1, nil
+}
+
+// sortObjects performs a stable sort of the values using the less
+// function, the first error stops comparing and is returned.
+func sortObjects(values []Object, less func(a, b Object) (bool, error)) error {
+	var err error
+	sort.SliceStable(
+		values, func(i, j int) bool {
+			if err != nil {
+				return false
+			}
+
+			var result bool
+			result, err = less(values[i], values[j])
+			return result
+		},
+	)
+
+	return err
+}
+
+func MakeListClassMethods(pkg *Package) StringDict {
+	methods := []*Method{
+		newListMethod(
+			pkg, "вставити", []MethodParameter{intParameter("індекс"), objectParameter("елемент")}, NilClass,
+			func(_ Context, self *List, args Tuple) (Object, error) {
+				length := Int(len(self.Values))
+				index, err := smallInt(args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				if index < 0 {
+					index += length
+				}
+
+				if index < 0 {
+					index = 0
+				} else if index > length {
+					index = length
+				}
+
+				self.Values = append(self.Values, nil)
+				copy(self.Values[index+1:], self.Values[index:])
+				self.Values[index] = args[1]
+				return Nil, nil
+			},
+		),
+		newListMethod(
+			pkg, "вилучити", []MethodParameter{intParameter("індекс")}, ObjectClass,
+			func(_ Context, self *List, args Tuple) (Object, error) {
+				index, err := smallInt(args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				index, err = self.normalizeIndex(index)
+				if err != nil {
+					return nil, err
+				}
+
+				element := self.Values[index]
+				self.Values = append(self.Values[:index], self.Values[index+1:]...)
+				return element, nil
+			},
+		),
+		newListMethod(
+			pkg, "вилучити_останній", nil, ObjectClass,
+			func(_ Context, self *List, _ Tuple) (Object, error) {
+				length := len(self.Values)
+				if length == 0 {
+					return nil, NewIndexOutOfRangeErrorf("неможливо вилучити елемент з порожнього списку")
+				}
+
+				element := self.Values[length-1]
+				self.Values = self.Values[:length-1]
+				return element, nil
+			},
+		),
+		newListMethod(
+			pkg, "видалити", []MethodParameter{objectParameter("елемент")}, NilClass,
+			func(ctx Context, self *List, args Tuple) (Object, error) {
+				index, err := self.indexOf(ctx, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				if index == -1 {
+					return nil, NewValueErrorf("список не містить елемента, який потрібно видалити")
+				}
+
+				self.Values = append(self.Values[:index], self.Values[index+1:]...)
+				return Nil, nil
+			},
+		),
+		newListMethod(
+			pkg, "індекс", []MethodParameter{objectParameter("елемент")}, IntClass,
+			func(ctx Context, self *List, args Tuple) (Object, error) {
+				index, err := self.indexOf(ctx, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				if index == -1 {
+					return nil, NewValueErrorf("список не містить шуканого елемента")
+				}
+
+				return Int(index), nil
+			},
+		),
+		newListMethod(
+			pkg, "кількість", []MethodParameter{objectParameter("елемент")}, IntClass,
+			func(ctx Context, self *List, args Tuple) (Object, error) {
+				count := 0
+				for _, element := range self.Values {
+					equals, err := goBool(ctx, Equals, element, args[0])
+					if err != nil {
+						return nil, err
+					}
+
+					if equals {
+						count++
+					}
+				}
+
+				return Int(count), nil
+			},
+		),
+		newListMethod(
+			pkg, "розширити", []MethodParameter{objectParameter("ітерований")}, NilClass,
+			func(ctx Context, self *List, args Tuple) (Object, error) {
+				var elements []Object
+				err := IterateOver(
+					ctx, args[0], func(element Object) (bool, error) {
+						elements = append(elements, element)
+						return false, nil
+					},
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				self.Values = append(self.Values, elements...)
+				return Nil, nil
+			},
+		),
+		newListMethod(
+			pkg, "обернути", nil, NilClass,
+			func(_ Context, self *List, _ Tuple) (Object, error) {
+				for i, j := 0, len(self.Values)-1; i < j; i, j = i+1, j-1 {
+					self.Values[i], self.Values[j] = self.Values[j], self.Values[i]
+				}
+
+				return Nil, nil
+			},
+		),
+		newListMethod(
+			pkg, "очистити", nil, NilClass,
+			func(_ Context, self *List, _ Tuple) (Object, error) {
+				self.Values = nil
+				return Nil, nil
+			},
+		),
+		newListMethod(
+			pkg, "копія", nil, ListClass,
+			func(_ Context, self *List, _ Tuple) (Object, error) {
+				list := NewList()
+				list.Values = make([]Object, len(self.Values))
+				copy(list.Values, self.Values)
+				return list, nil
+			},
+		),
+		newListMethod(
+			pkg, "сортувати", nil, NilClass,
+			func(ctx Context, self *List, _ Tuple) (Object, error) {
+				err := sortObjects(
+					self.Values, func(a, b Object) (bool, error) {
+						return goBool(ctx, Less, a, b)
+					},
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				return Nil, nil
+			},
+		),
+		newListMethod(
+			pkg, "сортувати_за", []MethodParameter{objectParameter("ключ")}, NilClass,
+			func(ctx Context, self *List, args Tuple) (Object, error) {
+				sorted, err := SortByKey(ctx, self.Values, args[0], false)
+				if err != nil {
+					return nil, err
+				}
+
+				self.Values = sorted
+				return Nil, nil
+			},
+		),
+		newListMethod(
+			pkg, "сортувати_з_порівнянням", []MethodParameter{objectParameter("порівняння")}, NilClass,
+			func(ctx Context, self *List, args Tuple) (Object, error) {
+				err := sortObjects(
+					self.Values, func(a, b Object) (bool, error) {
+						result, err := Call(ctx, args[0], Tuple{a, b})
+						if err != nil {
+							return false, err
+						}
+
+						order, ok := result.(Int)
+						if !ok {
+							return false, NewTypeErrorf(
+								"функція порівняння має повертати значення типу '%s', отримано '%s'",
+								IntClass.Name,
+								result.Class().Name,
+							)
+						}
+
+						return order < 0, nil
+					},
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				return Nil, nil
+			},
+		),
+	}
+
+	dict := StringDict{}
+	for _, method := range methods {
+		dict[method.Name] = method
+	}
+
+	return dict
+}
@@ -129,18 +129,21 @@ func (value *Method) Class() *Class {
 }
 
 func (value *Method) call(parentCtx Context, args Tuple) (Object, error) {
-	pLen := len(value.Parameters)
-	aLen := len(args)
-	if pLen != aLen {
-		return nil, NewErrorf("кількість параметрів не дорівнює кількості аргументів, %d != %d", pLen, aLen)
+	args, err := value.packArgs(args)
+	if err != nil {
+		return nil, err
 	}
 
-	// TODO: take into account variable parameters!
-
 	kwargs := StringDict{}
 	for i, arg := range args {
 		parameter := value.Parameters[i]
-		if err := checkArg(&parameter, arg); err != nil {
+		if parameter.IsVariadic {
+			for _, element := range *arg.(*Tuple) {
+				if err := checkArg(&parameter, element); err != nil {
+					return nil, err
+				}
+			}
+		} else if err := checkArg(&parameter, arg); err != nil {
 			return nil, err
 		}
 
@@ -161,6 +164,27 @@ func (value *Method) call(parentCtx Context, args Tuple) (Object, error) {
 	return result, nil
 }
 
+// packArgs checks the number of arguments and, if the last parameter
+// is variadic, packs the rest of arguments into a tuple.
+func (value *Method) packArgs(args Tuple) (Tuple, error) {
+	pLen := len(value.Parameters)
+	aLen := len(args)
+	if pLen == 0 || !value.Parameters[pLen-1].IsVariadic {
+		if pLen != aLen {
+			return nil, NewErrorf("кількість параметрів не дорівнює кількості аргументів, %d != %d", pLen, aLen)
+		}
+
+		return args, nil
+	}
+
+	if aLen < pLen-1 {
+		return nil, NewErrorf("кількість аргументів менша за кількість обов'язкових параметрів, %d < %d", aLen, pLen-1)
+	}
+
+	rest := append(Tuple{}, args[pLen-1:]...)
+	return append(append(Tuple{}, args[:pLen-1]...), &rest), nil
+}
+
 func (value *Method) IsMethod() bool {
 	return value.typ == method
 }
@@ -226,7 +250,8 @@ func (value *Method) checkCallResult(result Object) error {
 	case 0:
 		panic("unreachable")
 	case 1:
-		if accepts(value.ReturnTypes[0].Class, result.Class()) {
+		returnType := value.ReturnTypes[0]
+		if result == Nil && returnType.IsNullable || accepts(returnType.Class, result.Class()) {
 			return nil
 		}
 	default:
@@ -21,6 +21,10 @@ func (value NilType) string(ctx Context) (Object, error) {
 	return value.represent(ctx)
 }
 
+func (value NilType) hash(_ Context) (Object, error) {
+	return Int(0), nil
+}
+
 func (value NilType) toBool(_ Context) (Object, error) {
 	return False, nil
 }
@@ -3,7 +3,16 @@ package types
 import (
 	"fmt"
 	"math"
+	"reflect"
 	"strings"
+	"sync"
+)
+
+// Parameters of the 64-bit FNV-1a hash, which is used
+// to combine hashes of elements of containers.
+const (
+	fnvOffsetBasis uint64 = 14695981039346656037
+	fnvPrime       uint64 = 1099511628211
 )
 
 func mod(l, r Real) Real {
@@ -112,6 +121,166 @@ func ToReal(ctx Context, a Object) (Object, error) {
 	return nil, NewTypeErrorf("непідтримуваний тип операнда для 'дійсне': '%s'", a.Class().Name)
 }
 
+func ToComplex(_ Context, a Object) (Object, error) {
+	c, ok, err := complexOperand(a)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, NewTypeErrorf("непідтримуваний тип операнда для 'комплексне': '%s'", a.Class().Name)
+	}
+
+	return Complex(c), nil
+}
+
+// Hash calculates a hash value of the Object.
+//
+// Will raise TypeError if the object is not hashable.
+func Hash(ctx Context, a Object) (Object, error) {
+	if v, ok := a.(IHash); ok {
+		result, err := v.hash(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := result.(Int); !ok {
+			return nil, NewTypeErrorf("результат хешування має бути типу 'ціле', отримано '%s'", result.Class().Name)
+		}
+
+		return result, nil
+	}
+
+	return nil, NewTypeErrorf("нехешований тип: '%s'", a.Class().Name)
+}
+
+// Is checks whether 'a' and 'b' are the same object.
+//
+// Values of immutable value types, i.e. numbers, strings, logical
+// values and nil, are the same if they have the same type and value.
+func Is(a, b Object) Bool {
+	if aValue, ok := a.(Real); ok {
+		// NaN is not equal to itself, so compare the bits instead.
+		bValue, ok := b.(Real)
+		return gb2bo(ok && math.Float64bits(float64(aValue)) == math.Float64bits(float64(bValue)))
+	}
+
+	return gb2bo(a == b)
+}
+
+// identityKey is a key of an interned value in valueIdentities.
+type identityKey struct {
+	class *Class
+	value interface{}
+}
+
+// valueIdentities holds identities of values of value types, which are
+// negative, so they never collide with addresses of reference types.
+var valueIdentities = struct {
+	sync.Mutex
+	ids  map[identityKey]Int
+	last Int
+}{ids: map[identityKey]Int{}}
+
+// Identity returns an integer which is unique for the Object during
+// its lifetime, so Identity(a) == Identity(b) if and only if Is(a, b).
+//
+// Identity of reference types is the address of the object. Values of
+// value types are interned, so each distinct value of a type gets its
+// own identity on the first call.
+func Identity(a Object) Int {
+	switch reflect.ValueOf(a).Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return Int(reflect.ValueOf(a).Pointer())
+	}
+
+	key := identityKey{class: a.Class(), value: a}
+	if value, ok := a.(Real); ok {
+		// Is compares reals by bits, so NaN is the same as itself.
+		key.value = math.Float64bits(float64(value))
+	}
+
+	valueIdentities.Lock()
+	defer valueIdentities.Unlock()
+
+	id, ok := valueIdentities.ids[key]
+	if !ok {
+		valueIdentities.last--
+		id = valueIdentities.last
+		valueIdentities.ids[key] = id
+	}
+
+	return id
+}
+
+// Iterate returns an iterator of the Object.
+//
+// Will raise TypeError if the object is not iterable.
+func Iterate(ctx Context, a Object) (Object, error) {
+	if v, ok := a.(IIterate); ok {
+		result, err := v.iterate(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if result != nil {
+			return result, nil
+		}
+	} else if v, ok := a.(ISequence); ok {
+		return NewSequenceIterator(v), nil
+	}
+
+	return nil, NewTypeErrorf("об'єкт з типом '%s' не є ітерованим", a.Class().Name)
+}
+
+// Next returns the next element of the iterator.
+//
+// Will raise StopIterationError if the iterator is exhausted and
+// TypeError if the object is not an iterator.
+func Next(ctx Context, a Object) (Object, error) {
+	if v, ok := a.(INext); ok {
+		result, err := v.next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if result != nil {
+			return result, nil
+		}
+	}
+
+	return nil, NewTypeErrorf("об'єкт з типом '%s' не є ітератором", a.Class().Name)
+}
+
+// IterateOver calls 'f' for each element of the iterable Object
+// until the iterator is exhausted or 'f' returns true.
+func IterateOver(ctx Context, a Object, f func(element Object) (bool, error)) error {
+	iterator, err := Iterate(ctx, a)
+	if err != nil {
+		return err
+	}
+
+	for {
+		element, err := Next(ctx, iterator)
+		if err != nil {
+			if _, ok := err.(*StopIterationError); ok {
+				return nil
+			}
+
+			return err
+		}
+
+		stop, err := f(element)
+		if err != nil {
+			return err
+		}
+
+		if stop {
+			return nil
+		}
+	}
+}
+
 // ToGoInt turns 'a' into Go int if possible.
 func ToGoInt(ctx Context, a Object) (int, error) {
 	a, err := ToInt(ctx, a)
@@ -316,6 +485,18 @@ func getAttributeFrom(dict *StringDict, name string, cls *Class) (Object, error)
 	return nil, NewErrorf("об'єкт '%s' не містить атрибута '%s'", cls.Name, name)
 }
 
+// getNativeAttribute looks for an attribute in the class of the
+// built-in type's instance, which has no dict, and binds methods
+// to the instance.
+func getNativeAttribute(instance Object, name string) (Object, error) {
+	if attr := instance.Class().GetAttributeOrNil(name); attr != nil {
+		attr, _ = wrapMethod(instance, attr)
+		return attr, nil
+	}
+
+	return nil, NewAttributeErrorf("об'єкт '%s' не містить атрибута '%s'", instance.Class().Name, name)
+}
+
 func setAttributeTo(instance Object, dict *StringDict, attr Object, name string, value Object) error {
 	if attr != nil && !accepts(attr.Class(), value.Class()) {
 		if attr.Class() == MethodWrapperClass {
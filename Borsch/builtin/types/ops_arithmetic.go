@@ -12,6 +12,19 @@ func Add(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
+	if a.Class() != b.Class() {
+		if v, ok := b.(IReversedAdd); ok {
+			result, err := v.reversedAdd(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+
+			if result != nil {
+				return result, nil
+			}
+		}
+	}
+
 	return nil, NewErrorf(
 		"непідтримувані типи операндів для +: '%s' та '%s'",
 		a.Class().Name,
@@ -31,6 +44,19 @@ func Sub(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
+	if a.Class() != b.Class() {
+		if v, ok := b.(IReversedSub); ok {
+			result, err := v.reversedSub(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+
+			if result != nil {
+				return result, nil
+			}
+		}
+	}
+
 	return nil, NewErrorf(
 		"непідтримувані типи операндів для -: '%s' та '%s'",
 		a.Class().Name,
@@ -50,6 +76,19 @@ func Div(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
+	if a.Class() != b.Class() {
+		if v, ok := b.(IReversedDiv); ok {
+			result, err := v.reversedDiv(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+
+			if result != nil {
+				return result, nil
+			}
+		}
+	}
+
 	return nil, NewErrorf(
 		"непідтримувані типи операндів для /: '%s' та '%s'",
 		a.Class().Name,
@@ -69,6 +108,19 @@ func Mul(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
+	if a.Class() != b.Class() {
+		if v, ok := b.(IReversedMul); ok {
+			result, err := v.reversedMul(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+
+			if result != nil {
+				return result, nil
+			}
+		}
+	}
+
 	return nil, NewErrorf(
 		"непідтримувані типи операндів для *: '%s' та '%s'",
 		a.Class().Name,
@@ -88,6 +140,19 @@ func Mod(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
+	if a.Class() != b.Class() {
+		if v, ok := b.(IReversedMod); ok {
+			result, err := v.reversedMod(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+
+			if result != nil {
+				return result, nil
+			}
+		}
+	}
+
 	return nil, NewErrorf(
 		"непідтримувані типи операндів для %%: '%s' та '%s'",
 		a.Class().Name,
@@ -107,6 +172,19 @@ func Pow(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
+	if a.Class() != b.Class() {
+		if v, ok := b.(IReversedPow); ok {
+			result, err := v.reversedPow(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+
+			if result != nil {
+				return result, nil
+			}
+		}
+	}
+
 	return nil, NewErrorf(
 		"непідтримувані типи операндів для **: '%s' та '%s'",
 		a.Class().Name,
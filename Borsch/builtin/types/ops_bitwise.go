@@ -12,6 +12,19 @@ func ShiftLeft(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
+	if a.Class() != b.Class() {
+		if v, ok := b.(IReversedShiftLeft); ok {
+			result, err := v.reversedShiftLeft(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+
+			if result != nil {
+				return result, nil
+			}
+		}
+	}
+
 	return nil, NewErrorf(
 		"непідтримувані типи операндів для <<: '%s' та '%s'",
 		a.Class().Name,
@@ -31,6 +44,19 @@ func ShiftRight(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
+	if a.Class() != b.Class() {
+		if v, ok := b.(IReversedShiftRight); ok {
+			result, err := v.reversedShiftRight(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+
+			if result != nil {
+				return result, nil
+			}
+		}
+	}
+
 	return nil, NewErrorf(
 		"непідтримувані типи операндів для >>: '%s' та '%s'",
 		a.Class().Name,
@@ -50,6 +76,19 @@ func BitwiseOr(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
+	if a.Class() != b.Class() {
+		if v, ok := b.(IReversedBitwiseOr); ok {
+			result, err := v.reversedBitwiseOr(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+
+			if result != nil {
+				return result, nil
+			}
+		}
+	}
+
 	return nil, NewErrorf(
 		"непідтримувані типи операндів для |: '%s' та '%s'",
 		a.Class().Name,
@@ -69,6 +108,19 @@ func BitwiseXor(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
+	if a.Class() != b.Class() {
+		if v, ok := b.(IReversedBitwiseXor); ok {
+			result, err := v.reversedBitwiseXor(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+
+			if result != nil {
+				return result, nil
+			}
+		}
+	}
+
 	return nil, NewErrorf(
 		"непідтримувані типи операндів для ^: '%s' та '%s'",
 		a.Class().Name,
@@ -88,6 +140,19 @@ func BitwiseAnd(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
+	if a.Class() != b.Class() {
+		if v, ok := b.(IReversedBitwiseAnd); ok {
+			result, err := v.reversedBitwiseAnd(ctx, a)
+			if err != nil {
+				return nil, err
+			}
+
+			if result != nil {
+				return result, nil
+			}
+		}
+	}
+
 	return nil, NewErrorf(
 		"непідтримувані типи операндів для &: '%s' та '%s'",
 		a.Class().Name,
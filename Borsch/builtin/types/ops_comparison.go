@@ -25,11 +25,8 @@ func Equals(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
-	return nil, NewErrorf(
-		"непідтримувані типи операндів для ==: '%s' та '%s'",
-		a.Class().Name,
-		b.Class().Name,
-	)
+	// Objects which can not be compared are equal only to themselves.
+	return Is(a, b), nil
 }
 
 func NotEquals(ctx Context, a, b Object) (Object, error) {
@@ -57,11 +54,22 @@ func NotEquals(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
-	return nil, NewErrorf(
-		"непідтримувані типи операндів для !=: '%s' та '%s'",
-		a.Class().Name,
-		b.Class().Name,
-	)
+	// Objects which can not be compared are equal only to themselves.
+	return !Is(a, b), nil
+}
+
+// unorderable is the result of ordering comparisons of numbers with
+// other objects. Numbers unknown to the value, e.g. fractions, return
+// (nil, nil), so they compare themselves by the reversed operator.
+// Other objects are not tried, since mirrored comparisons of strings or
+// containers would report the operator and operands in wrong order.
+func unorderable(operator string, value, other Object) (Object, error) {
+	switch other.(type) {
+	case Int, *BigInt, Bool, Real, *Fraction, *Decimal:
+		return nil, nil
+	}
+
+	return nil, OperatorNotSupportedErrorNew(operator, value.Class().Name, other.Class().Name)
 }
 
 func Less(ctx Context, a, b Object) (Object, error) {
@@ -77,8 +85,8 @@ func Less(ctx Context, a, b Object) (Object, error) {
 	}
 
 	if a.Class() != b.Class() {
-		if v, ok := b.(ILess); ok {
-			result, err := v.less(ctx, a)
+		if v, ok := b.(IGreater); ok {
+			result, err := v.greater(ctx, a)
 			if err != nil {
 				return nil, err
 			}
@@ -89,11 +97,7 @@ func Less(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
-	return nil, NewErrorf(
-		"непідтримувані типи операндів для <: '%s' та '%s'",
-		a.Class().Name,
-		b.Class().Name,
-	)
+	return nil, OperatorNotSupportedErrorNew("<", a.Class().Name, b.Class().Name)
 }
 
 func LessOrEquals(ctx Context, a, b Object) (Object, error) {
@@ -109,8 +113,8 @@ func LessOrEquals(ctx Context, a, b Object) (Object, error) {
 	}
 
 	if a.Class() != b.Class() {
-		if v, ok := b.(ILessOrEquals); ok {
-			result, err := v.lessOrEquals(ctx, a)
+		if v, ok := b.(IGreaterOrEquals); ok {
+			result, err := v.greaterOrEquals(ctx, a)
 			if err != nil {
 				return nil, err
 			}
@@ -121,11 +125,7 @@ func LessOrEquals(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
-	return nil, NewErrorf(
-		"непідтримувані типи операндів для <=: '%s' та '%s'",
-		a.Class().Name,
-		b.Class().Name,
-	)
+	return nil, OperatorNotSupportedErrorNew("<=", a.Class().Name, b.Class().Name)
 }
 
 func Greater(ctx Context, a, b Object) (Object, error) {
@@ -141,8 +141,8 @@ func Greater(ctx Context, a, b Object) (Object, error) {
 	}
 
 	if a.Class() != b.Class() {
-		if v, ok := b.(IGreater); ok {
-			result, err := v.greater(ctx, a)
+		if v, ok := b.(ILess); ok {
+			result, err := v.less(ctx, a)
 			if err != nil {
 				return nil, err
 			}
@@ -153,11 +153,7 @@ func Greater(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
-	return nil, NewErrorf(
-		"непідтримувані типи операндів для >: '%s' та '%s'",
-		a.Class().Name,
-		b.Class().Name,
-	)
+	return nil, OperatorNotSupportedErrorNew(">", a.Class().Name, b.Class().Name)
 }
 
 func GreaterOrEquals(ctx Context, a, b Object) (Object, error) {
@@ -173,8 +169,8 @@ func GreaterOrEquals(ctx Context, a, b Object) (Object, error) {
 	}
 
 	if a.Class() != b.Class() {
-		if v, ok := b.(IGreaterOrEquals); ok {
-			result, err := v.greaterOrEquals(ctx, a)
+		if v, ok := b.(ILessOrEquals); ok {
+			result, err := v.lessOrEquals(ctx, a)
 			if err != nil {
 				return nil, err
 			}
@@ -185,9 +181,55 @@ func GreaterOrEquals(ctx Context, a, b Object) (Object, error) {
 		}
 	}
 
-	return nil, NewErrorf(
-		"непідтримувані типи операндів для >=: '%s' та '%s'",
-		a.Class().Name,
-		b.Class().Name,
+	return nil, OperatorNotSupportedErrorNew(">=", a.Class().Name, b.Class().Name)
+}
+
+// Contains checks whether the container includes the item. If the
+// container doesn't implement the membership test, its elements
+// are compared with the item one by one.
+func Contains(ctx Context, container, item Object) (Object, error) {
+	if v, ok := container.(IContains); ok {
+		result, err := v.contains(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+
+		if result != nil {
+			return ToBool(ctx, result)
+		}
+	}
+
+	found := false
+	err := IterateOver(
+		ctx, container, func(element Object) (bool, error) {
+			equal, err := goBool(ctx, Equals, element, item)
+			if err != nil {
+				return false, err
+			}
+
+			found = equal
+			return found, nil
+		},
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	return gb2bo(found), nil
+}
+
+// containsElement checks whether one of the elements equals to the item.
+func containsElement(ctx Context, elements []Object, item Object) (Object, error) {
+	for _, element := range elements {
+		equal, err := goBool(ctx, Equals, element, item)
+		if err != nil {
+			return nil, err
+		}
+
+		if equal {
+			return True, nil
+		}
+	}
+
+	return False, nil
 }
@@ -0,0 +1,59 @@
+package types
+
+// Copy returns a shallow copy of the object. Immutable objects and
+// objects which can not be copied are returned as is.
+func Copy(ctx Context, a Object) (Object, error) {
+	if v, ok := a.(ICopy); ok {
+		result, err := v.copy(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if result != nil {
+			return result, nil
+		}
+	}
+
+	return a, nil
+}
+
+// DeepCopy returns a copy of the object and recursively copies all
+// objects it contains. Objects which are referenced several times
+// are copied once, so cyclic references are preserved.
+func DeepCopy(ctx Context, a Object) (Object, error) {
+	return deepCopy(ctx, a, map[Object]Object{})
+}
+
+func deepCopy(ctx Context, a Object, memo map[Object]Object) (Object, error) {
+	if result, ok := memo[a]; ok {
+		return result, nil
+	}
+
+	if v, ok := a.(IDeepCopy); ok {
+		result, err := v.deepCopy(ctx, memo)
+		if err != nil {
+			return nil, err
+		}
+
+		if result != nil {
+			return result, nil
+		}
+	}
+
+	return a, nil
+}
+
+// deepCopyElements copies each element of the slice into the
+// destination slice using deepCopy.
+func deepCopyElements(ctx Context, dst, src []Object, memo map[Object]Object) error {
+	for i, element := range src {
+		elementCopy, err := deepCopy(ctx, element, memo)
+		if err != nil {
+			return err
+		}
+
+		dst[i] = elementCopy
+	}
+
+	return nil
+}
@@ -29,5 +29,10 @@ func Or(ctx Context, a, b Object) (Object, error) {
 }
 
 func Not(ctx Context, a Object) (Object, error) {
-	return ToBool(ctx, a)
+	result, err := ToBool(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return !result.(Bool), nil
 }
@@ -0,0 +1,252 @@
+package types
+
+import "fmt"
+
+var OrderedDictClass = ObjectClass.ClassNew(
+	"впорядкований_словник", map[string]Object{}, true, OrderedDictNew, nil,
+)
+
+// dictionaryBased is a mapping, which stores its entries in the
+// dictionary, so methods of mappings are shared.
+type dictionaryBased interface {
+	Object
+	dictionary() *Dictionary
+}
+
+// asDictionary returns the dictionary or the dictionary of the mapping
+// based on it.
+func asDictionary(obj Object) (*Dictionary, bool) {
+	switch value := obj.(type) {
+	case *Dictionary:
+		return value, true
+	case dictionaryBased:
+		return value.dictionary(), true
+	default:
+		return nil, false
+	}
+}
+
+// fillDictionary copies entries of the dictionary or the mapping based
+// on it to dict.
+func fillDictionary(ctx Context, cls *Class, dict *Dictionary, arg Object) error {
+	other, ok := asDictionary(arg)
+	if !ok {
+		return NewTypeErrorf("%s() аргумент має бути типу 'словник', отримано '%s'", cls.Name, arg.Class().Name)
+	}
+
+	for _, entry := range other.entries {
+		if _, err := dict.SetItem(ctx, entry.key, entry.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// representMapping represents the mapping as 'name({...})'.
+func representMapping(ctx Context, container dictionaryBased, prefix string) (Object, error) {
+	name := container.Class().Name
+	return representContainer(
+		container, String(fmt.Sprintf("%s(%s{...})", name, prefix)), func() (Object, error) {
+			str, err := container.dictionary().string(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			return String(fmt.Sprintf("%s(%s%s)", name, prefix, str)), nil
+		},
+	)
+}
+
+// deepCopyDictionary copies keys and values of the dictionary to dict.
+func deepCopyDictionary(ctx Context, dict, other *Dictionary, memo map[Object]Object) error {
+	for _, entry := range other.entries {
+		key, err := deepCopy(ctx, entry.key, memo)
+		if err != nil {
+			return err
+		}
+
+		item, err := deepCopy(ctx, entry.value, memo)
+		if err != nil {
+			return err
+		}
+
+		if _, err := dict.SetItem(ctx, key, item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OrderedDict is a dictionary, equality of which depends on the order
+// of keys, and the keys can be moved to the beginning or to the end.
+type OrderedDict struct {
+	dict *Dictionary
+}
+
+func NewOrderedDict() *OrderedDict {
+	return &OrderedDict{dict: NewDictionary()}
+}
+
+func OrderedDictNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	orderedDict := NewOrderedDict()
+	switch len(args) {
+	case 0:
+		return orderedDict, nil
+	case 1:
+		if err := fillDictionary(ctx, cls, orderedDict.dict, args[0]); err != nil {
+			return nil, err
+		}
+
+		return orderedDict, nil
+	default:
+		return nil, NewTypeErrorf("%s() приймає не більше 1 аргументу (отримано %d)", cls.Name, len(args))
+	}
+}
+
+func (value *OrderedDict) Class() *Class {
+	return OrderedDictClass
+}
+
+func (value *OrderedDict) dictionary() *Dictionary {
+	return value.dict
+}
+
+// MoveToEnd moves the key to the end, or to the beginning if last is
+// false.
+func (value *OrderedDict) MoveToEnd(ctx Context, key Object, last bool) error {
+	_, index, err := value.dict.find(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if index == -1 {
+		return newKeyNotFoundError(ctx, key)
+	}
+
+	entry := value.dict.entries[index]
+	entries := append(value.dict.entries[:index:index], value.dict.entries[index+1:]...)
+	if last {
+		entries = append(entries, entry)
+	} else {
+		entries = append([]dictionaryEntry{entry}, entries...)
+	}
+
+	value.dict.entries = entries
+	return value.dict.rebuildBuckets(ctx)
+}
+
+// PopItem removes and returns the last entry as the tuple of the key
+// and the value, or the first entry if last is false.
+func (value *OrderedDict) PopItem(ctx Context, last bool) (Object, error) {
+	if err := checkNotEmpty(len(value.dict.entries), "впорядкований словник порожній"); err != nil {
+		return nil, err
+	}
+
+	entry := value.dict.entries[0]
+	if last {
+		entry = value.dict.entries[len(value.dict.entries)-1]
+	}
+
+	if _, err := value.dict.DeleteItem(ctx, entry.key); err != nil {
+		return nil, err
+	}
+
+	return &Tuple{entry.key, entry.value}, nil
+}
+
+func (value *OrderedDict) Length(ctx Context) (Int, error) {
+	return value.dict.Length(ctx)
+}
+
+func (value *OrderedDict) GetItem(ctx Context, key Object) (Object, error) {
+	return value.dict.GetItem(ctx, key)
+}
+
+func (value *OrderedDict) SetItem(ctx Context, key Object, item Object) (Object, error) {
+	if _, err := value.dict.SetItem(ctx, key, item); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *OrderedDict) DeleteItem(ctx Context, key Object) (Object, error) {
+	return value.dict.DeleteItem(ctx, key)
+}
+
+func (value *OrderedDict) represent(ctx Context) (Object, error) {
+	return representMapping(ctx, value, "")
+}
+
+func (value *OrderedDict) string(ctx Context) (Object, error) {
+	return value.represent(ctx)
+}
+
+func (value *OrderedDict) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+// equals compares the order of keys only if the other object is
+// an ordered dictionary too.
+func (value *OrderedDict) equals(ctx Context, other Object) (Object, error) {
+	if o, ok := other.(*OrderedDict); ok {
+		result, err := compareContainers(
+			value, o, func() (int, error) {
+				if len(value.dict.entries) != len(o.dict.entries) {
+					return 1, nil
+				}
+
+				result, err := compareSequences(ctx, value.dict.Keys(), o.dict.Keys(), true)
+				if err != nil || result != 0 {
+					return result, err
+				}
+
+				return value.dict.compareWith(ctx, o.dict)
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return gb2bo(result == 0), nil
+	}
+
+	return value.dict.equals(ctx, other)
+}
+
+func (value *OrderedDict) notEquals(ctx Context, other Object) (Object, error) {
+	result, err := value.equals(ctx, other)
+	if err != nil {
+		return nil, err
+	}
+
+	return !result.(Bool), nil
+}
+
+func (value *OrderedDict) toBool(ctx Context) (Object, error) {
+	return value.dict.toBool(ctx)
+}
+
+func (value *OrderedDict) contains(ctx Context, item Object) (Object, error) {
+	return value.dict.contains(ctx, item)
+}
+
+func (value *OrderedDict) iterate(ctx Context) (Object, error) {
+	return value.dict.iterate(ctx)
+}
+
+func (value *OrderedDict) copy(ctx Context) (Object, error) {
+	return OrderedDictNew(ctx, OrderedDictClass, Tuple{value})
+}
+
+func (value *OrderedDict) deepCopy(ctx Context, memo map[Object]Object) (Object, error) {
+	orderedDict := NewOrderedDict()
+	memo[value] = orderedDict
+	if err := deepCopyDictionary(ctx, orderedDict.dict, value.dict, memo); err != nil {
+		return nil, err
+	}
+
+	return orderedDict, nil
+}
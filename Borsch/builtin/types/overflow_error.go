@@ -0,0 +1,42 @@
+package types
+
+import "fmt"
+
+var OverflowErrorClass *Class
+
+type OverflowError struct {
+	message string
+}
+
+func (value *OverflowError) Error() string {
+	return fmt.Sprintf("%s: %s", value.Class().Name, value.message)
+}
+
+func (value *OverflowError) Class() *Class {
+	return OverflowErrorClass
+}
+
+func OverflowErrorNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	message, err := errorMessageFromArgs(ctx, cls, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OverflowError{message: message}, nil
+}
+
+func NewOverflowError(text string) *OverflowError {
+	return &OverflowError{message: text}
+}
+
+func NewOverflowErrorf(format string, args ...interface{}) *OverflowError {
+	return &OverflowError{message: fmt.Sprintf(format, args...)}
+}
+
+func (value *OverflowError) represent(ctx Context) (Object, error) {
+	return value.string(ctx)
+}
+
+func (value *OverflowError) string(_ Context) (Object, error) {
+	return String(value.message), nil
+}
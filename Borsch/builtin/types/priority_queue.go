@@ -0,0 +1,245 @@
+package types
+
+import "fmt"
+
+var PriorityQueueClass = ObjectClass.ClassNew(
+	"черга_з_пріоритетом", map[string]Object{}, true, PriorityQueueNew, nil,
+)
+
+type priorityQueueEntry struct {
+	element Object
+	key     Object
+
+	// order is the number of the addition, elements with equal keys
+	// are taken in the order of addition.
+	order uint64
+}
+
+// PriorityQueue is a binary heap, which gives the element with the
+// smallest key first. The key is the result of the key function or the
+// element itself, it is computed once, when the element is added.
+type PriorityQueue struct {
+	entries   []priorityQueueEntry
+	keyFunc   Object
+	nextOrder uint64
+}
+
+// PriorityQueueNew creates the queue with the optional key function,
+// which may be нуль.
+func PriorityQueueNew(_ Context, cls *Class, args Tuple) (Object, error) {
+	switch len(args) {
+	case 0:
+		return &PriorityQueue{}, nil
+	case 1:
+		if args[0] == Nil {
+			return &PriorityQueue{}, nil
+		}
+
+		if _, ok := args[0].(ICall); !ok {
+			return nil, NewTypeErrorf("ключ має бути функцією, отримано '%s'", args[0].Class().Name)
+		}
+
+		return &PriorityQueue{keyFunc: args[0]}, nil
+	default:
+		return nil, NewTypeErrorf("%s() приймає не більше 1 аргументу (отримано %d)", cls.Name, len(args))
+	}
+}
+
+func (value *PriorityQueue) Class() *Class {
+	return PriorityQueueClass
+}
+
+func (value *PriorityQueue) less(ctx Context, i, j int) (bool, error) {
+	a, b := value.entries[i], value.entries[j]
+	if !Is(a.key, b.key) {
+		less, err := goBool(ctx, Less, a.key, b.key)
+		if err != nil || less {
+			return less, err
+		}
+
+		greater, err := goBool(ctx, Less, b.key, a.key)
+		if err != nil || greater {
+			return false, err
+		}
+	}
+
+	return a.order < b.order, nil
+}
+
+func (value *PriorityQueue) siftUp(ctx Context, i int) error {
+	for i > 0 {
+		parent := (i - 1) / 2
+		less, err := value.less(ctx, i, parent)
+		if err != nil || !less {
+			return err
+		}
+
+		value.entries[i], value.entries[parent] = value.entries[parent], value.entries[i]
+		i = parent
+	}
+
+	return nil
+}
+
+func (value *PriorityQueue) siftDown(ctx Context, i int) error {
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child >= len(value.entries) {
+				continue
+			}
+
+			less, err := value.less(ctx, child, smallest)
+			if err != nil {
+				return err
+			}
+
+			if less {
+				smallest = child
+			}
+		}
+
+		if smallest == i {
+			return nil
+		}
+
+		value.entries[i], value.entries[smallest] = value.entries[smallest], value.entries[i]
+		i = smallest
+	}
+}
+
+// Push adds the element computing its key. The queue is not changed if
+// the key can not be compared with keys of other elements.
+func (value *PriorityQueue) Push(ctx Context, element Object) error {
+	key := element
+	if value.keyFunc != nil {
+		var err error
+		if key, err = Call(ctx, value.keyFunc, Tuple{element}); err != nil {
+			return err
+		}
+	}
+
+	value.entries = append(value.entries, priorityQueueEntry{element: element, key: key, order: value.nextOrder})
+	if err := value.siftUp(ctx, len(value.entries)-1); err != nil {
+		value.entries = value.entries[:len(value.entries)-1]
+		value.rebuild(ctx)
+		return err
+	}
+
+	value.nextOrder++
+	return nil
+}
+
+// rebuild restores the heap after the failed comparison, all keys are
+// already compared successfully.
+func (value *PriorityQueue) rebuild(ctx Context) {
+	for i := len(value.entries)/2 - 1; i >= 0; i-- {
+		_ = value.siftDown(ctx, i)
+	}
+}
+
+// Pop removes and returns the element with the smallest key.
+func (value *PriorityQueue) Pop(ctx Context) (Object, error) {
+	if err := checkNotEmpty(len(value.entries), "черга з пріоритетом порожня"); err != nil {
+		return nil, err
+	}
+
+	element := value.entries[0].element
+	last := len(value.entries) - 1
+	value.entries[0] = value.entries[last]
+	value.entries = value.entries[:last]
+	if err := value.siftDown(ctx, 0); err != nil {
+		return nil, err
+	}
+
+	return element, nil
+}
+
+// Peek returns the element with the smallest key.
+func (value *PriorityQueue) Peek() (Object, error) {
+	if err := checkNotEmpty(len(value.entries), "черга з пріоритетом порожня"); err != nil {
+		return nil, err
+	}
+
+	return value.entries[0].element, nil
+}
+
+func (value *PriorityQueue) Clear() {
+	value.entries = nil
+}
+
+// Elements returns elements in the order they would be taken.
+func (value *PriorityQueue) Elements(ctx Context) ([]Object, error) {
+	queue := &PriorityQueue{entries: append([]priorityQueueEntry{}, value.entries...)}
+	elements := make([]Object, 0, len(value.entries))
+	for len(queue.entries) != 0 {
+		element, err := queue.Pop(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	return elements, nil
+}
+
+func (value *PriorityQueue) represent(ctx Context) (Object, error) {
+	return representContainer(
+		value, String(fmt.Sprintf("%s([...])", PriorityQueueClass.Name)), func() (Object, error) {
+			elements, err := value.Elements(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			str, err := representElements(ctx, elements)
+			if err != nil {
+				return nil, err
+			}
+
+			return String(fmt.Sprintf("%s([%s])", PriorityQueueClass.Name, str)), nil
+		},
+	)
+}
+
+func (value *PriorityQueue) string(ctx Context) (Object, error) {
+	return value.represent(ctx)
+}
+
+func (value *PriorityQueue) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+func (value *PriorityQueue) Length(_ Context) (Int, error) {
+	return Int(len(value.entries)), nil
+}
+
+func (value *PriorityQueue) toBool(_ Context) (Object, error) {
+	return gb2bo(len(value.entries) != 0), nil
+}
+
+func (value *PriorityQueue) contains(ctx Context, item Object) (Object, error) {
+	elements := make([]Object, len(value.entries))
+	for i, entry := range value.entries {
+		elements[i] = entry.element
+	}
+
+	return containsElement(ctx, elements, item)
+}
+
+func (value *PriorityQueue) iterate(ctx Context) (Object, error) {
+	elements, err := value.Elements(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sliceIterator{elements: elements, index: 0}, nil
+}
+
+func (value *PriorityQueue) copy(_ Context) (Object, error) {
+	return &PriorityQueue{
+		entries:   append([]priorityQueueEntry{}, value.entries...),
+		keyFunc:   value.keyFunc,
+		nextOrder: value.nextOrder,
+	}, nil
+}
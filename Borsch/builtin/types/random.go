@@ -0,0 +1,330 @@
+package types
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"math"
+	"time"
+)
+
+var GeneratorClass = ObjectClass.ClassNew("генератор", map[string]Object{}, true, GeneratorNew, nil)
+
+// randomSource gives uniformly distributed 32-bit integers.
+type randomSource interface {
+	Uint32() uint32
+}
+
+const (
+	mtN         = 624
+	mtM         = 397
+	mtMatrixA   = 0x9908b0df
+	mtUpperMask = 0x80000000
+	mtLowerMask = 0x7fffffff
+
+	// mtDefaultSeed is used if the generator is not seeded.
+	mtDefaultSeed = 5489
+)
+
+// mt19937 is the Mersenne Twister by Makoto Matsumoto and Takuji
+// Nishimura. Its output is the same as of genrand_int32() of the
+// original implementation for the same seed.
+type mt19937 struct {
+	state [mtN]uint32
+	index int
+}
+
+func newMT19937(seed uint32) *mt19937 {
+	mt := &mt19937{}
+	mt.Seed(seed)
+	return mt
+}
+
+func (mt *mt19937) Seed(seed uint32) {
+	mt.state[0] = seed
+	for i := 1; i < mtN; i++ {
+		mt.state[i] = 1812433253*(mt.state[i-1]^(mt.state[i-1]>>30)) + uint32(i)
+	}
+
+	mt.index = mtN
+}
+
+func (mt *mt19937) twist() {
+	mag01 := [2]uint32{0, mtMatrixA}
+	for i := 0; i < mtN; i++ {
+		y := mt.state[i]&mtUpperMask | mt.state[(i+1)%mtN]&mtLowerMask
+		mt.state[i] = mt.state[(i+mtM)%mtN] ^ y>>1 ^ mag01[y&1]
+	}
+
+	mt.index = 0
+}
+
+func (mt *mt19937) Uint32() uint32 {
+	if mt.index >= mtN {
+		mt.twist()
+	}
+
+	y := mt.state[mt.index]
+	mt.index++
+	y ^= y >> 11
+	y ^= y << 7 & 0x9d2c5680
+	y ^= y << 15 & 0xefc60000
+	y ^= y >> 18
+	return y
+}
+
+// readSecureRandom fills the buffer from the cryptographically secure
+// generator of the operating system.
+var readSecureRandom = rand.Read
+
+// secureSource reads random numbers from the cryptographically secure
+// generator of the operating system.
+type secureSource struct {
+	// err is the first error of reading since the last call of
+	// takeError, numbers read after it are zeros.
+	err error
+}
+
+func (source *secureSource) Uint32() uint32 {
+	var buffer [4]byte
+	if _, err := readSecureRandom(buffer[:]); err != nil {
+		if source.err == nil {
+			source.err = err
+		}
+
+		return 0
+	}
+
+	return binary.LittleEndian.Uint32(buffer[:])
+}
+
+// takeError returns IOError if reading of random numbers failed since
+// the last call, so the result computed from them must be discarded.
+func (source *secureSource) takeError() error {
+	if source.err == nil {
+		return nil
+	}
+
+	source.err = nil
+	return NewIOError("неможливо отримати випадкові дані від операційної системи")
+}
+
+// Generator is a generator of pseudo-random numbers. It uses MT19937,
+// which is reproducible for the same seed, or the cryptographically
+// secure source, which can not be seeded.
+type Generator struct {
+	source randomSource
+}
+
+// NewGenerator creates the MT19937 generator with the seed.
+func NewGenerator(seed uint32) *Generator {
+	return &Generator{source: newMT19937(seed)}
+}
+
+// NewUnseededGenerator creates the MT19937 generator with the default
+// seed of the original implementation.
+func NewUnseededGenerator() *Generator {
+	return NewGenerator(mtDefaultSeed)
+}
+
+// NewRandomGenerator creates the MT19937 generator with the seed from
+// the secure source, or from the current time if the source fails.
+func NewRandomGenerator() *Generator {
+	source := &secureSource{}
+	seed := source.Uint32()
+	if source.takeError() != nil {
+		seed = uint32(time.Now().UnixNano())
+	}
+
+	return NewGenerator(seed)
+}
+
+// NewSecureGenerator creates the generator with the cryptographically
+// secure source.
+func NewSecureGenerator() *Generator {
+	return &Generator{source: &secureSource{}}
+}
+
+// GeneratorNew creates the generator with the seed, or with the random
+// seed if it is not given.
+func GeneratorNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	switch len(args) {
+	case 0:
+		return NewRandomGenerator(), nil
+	case 1:
+		seed, err := seedFromObject(ctx, args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return NewGenerator(seed), nil
+	default:
+		return nil, NewTypeErrorf("%s() приймає не більше 1 аргументу (отримано %d)", cls.Name, len(args))
+	}
+}
+
+// seedFromObject takes the lower 32 bits of the integer.
+func seedFromObject(ctx Context, obj Object) (uint32, error) {
+	switch value := obj.(type) {
+	case Int, Bool:
+		n, err := ToGoInt(ctx, value)
+		if err != nil {
+			return 0, err
+		}
+
+		return uint32(n), nil
+	case *BigInt:
+		return uint32(value.Big().Uint64()), nil
+	default:
+		return 0, NewTypeErrorf("насіння має бути цілим числом, отримано '%s'", obj.Class().Name)
+	}
+}
+
+// Seed restarts the MT19937 generator with the seed.
+func (value *Generator) Seed(seed uint32) error {
+	mt, ok := value.source.(*mt19937)
+	if !ok {
+		return NewValueErrorf("криптографічно безпечний генератор не підтримує насіння")
+	}
+
+	mt.Seed(seed)
+	return nil
+}
+
+func (value *Generator) Uint32() uint32 {
+	return value.source.Uint32()
+}
+
+// failed checks whether reading from the secure source failed since
+// the last call of takeError.
+func (value *Generator) failed() bool {
+	source, ok := value.source.(*secureSource)
+	return ok && source.err != nil
+}
+
+// takeError returns the error of the secure source, which occurred
+// since the last call.
+func (value *Generator) takeError() error {
+	if source, ok := value.source.(*secureSource); ok {
+		return source.takeError()
+	}
+
+	return nil
+}
+
+// Float53 returns the real number in [0, 1) with 53 random bits, the
+// same as genrand_res53() of the original implementation.
+func (value *Generator) Float53() float64 {
+	a := value.Uint32() >> 5
+	b := value.Uint32() >> 6
+	return (float64(a)*67108864.0 + float64(b)) * (1.0 / 9007199254740992.0)
+}
+
+// Uint64n returns the integer in [0, n) without bias, n == 0 means
+// the whole range of uint64.
+func (value *Generator) Uint64n(n uint64) uint64 {
+	if n != 0 && n <= 1<<32 {
+		limit := 1<<32 - 1<<32%n
+		for {
+			if x := uint64(value.Uint32()); x < limit {
+				return x % n
+			}
+		}
+	}
+
+	for {
+		x := uint64(value.Uint32())<<32 | uint64(value.Uint32())
+		if n == 0 {
+			return x
+		}
+
+		if x <= math.MaxUint64-(math.MaxUint64%n+1)%n {
+			return x % n
+		}
+	}
+}
+
+// IntBetween returns the integer in [min, max].
+func (value *Generator) IntBetween(min, max int64) (int64, error) {
+	if min > max {
+		return 0, NewValueErrorf("мінімум %d більший за максимум %d", min, max)
+	}
+
+	return min + int64(value.Uint64n(uint64(max-min)+1)), nil
+}
+
+// Normal uses the Box-Muller transform.
+func (value *Generator) Normal(mean, deviation float64) float64 {
+	u1 := 1 - value.Float53()
+	u2 := value.Float53()
+	return mean + deviation*math.Sqrt(-2*math.Log(u1))*math.Cos(2*math.Pi*u2)
+}
+
+func (value *Generator) Exponential(rate float64) float64 {
+	return -math.Log(1-value.Float53()) / rate
+}
+
+// Gamma uses the method of Marsaglia and Tsang.
+func (value *Generator) Gamma(shape, scale float64) float64 {
+	if shape < 1 {
+		return value.Gamma(shape+1, scale) * math.Pow(1-value.Float53(), 1/shape)
+	}
+
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		// Zeros of the failed source are never accepted, and the result
+		// is discarded anyway.
+		if value.failed() {
+			return 0
+		}
+
+		x := value.Normal(0, 1)
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+
+		v = v * v * v
+		u := 1 - value.Float53()
+		if math.Log(u) < x*x/2+d-d*v+d*math.Log(v) {
+			return d * v * scale
+		}
+	}
+}
+
+func (value *Generator) Triangular(low, high, mode float64) float64 {
+	u := value.Float53()
+	if c := (mode - low) / (high - low); high == low || u < c {
+		return low + math.Sqrt(u*(high-low)*(mode-low))
+	}
+
+	return high - math.Sqrt((1-u)*(high-low)*(high-mode))
+}
+
+// Shuffle permutes the elements with the Fisher-Yates algorithm.
+func (value *Generator) Shuffle(elements []Object) {
+	for i := len(elements) - 1; i > 0; i-- {
+		j := value.Uint64n(uint64(i) + 1)
+		elements[i], elements[j] = elements[j], elements[i]
+	}
+}
+
+func (value *Generator) Class() *Class {
+	return GeneratorClass
+}
+
+func (value *Generator) represent(Context) (Object, error) {
+	if _, ok := value.source.(*secureSource); ok {
+		return String("<криптографічно безпечний генератор>"), nil
+	}
+
+	return String("<генератор MT19937>"), nil
+}
+
+func (value *Generator) string(ctx Context) (Object, error) {
+	return value.represent(ctx)
+}
+
+func (value *Generator) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
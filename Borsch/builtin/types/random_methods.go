@@ -0,0 +1,371 @@
+package types
+
+import (
+	"math"
+	"unicode/utf8"
+)
+
+type generatorMethodFunc func(ctx Context, self *Generator, args Tuple) (Object, error)
+
+// newGeneratorMethod creates a method of 'генератор' class, the first
+// parameter of which is the generator itself.
+func newGeneratorMethod(
+	pkg *Package,
+	name string,
+	parameters []MethodParameter,
+	returnType *Class,
+	f generatorMethodFunc,
+) *Method {
+	return MethodNew(
+		name,
+		pkg,
+		append(
+			[]MethodParameter{
+				{
+					Class:      GeneratorClass,
+					Classes:    nil,
+					Name:       "я",
+					IsNullable: false,
+					IsVariadic: false,
+				},
+			},
+			parameters...,
+		),
+		[]MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: returnType == NilClass,
+			},
+		},
+		func(ctx Context, args Tuple, _ StringDict) (Object, error) {
+			self := args[0].(*Generator)
+			result, err := f(ctx, self, args[1:])
+			if sourceErr := self.takeError(); sourceErr != nil {
+				return nil, sourceErr
+			}
+
+			return result, err
+		},
+	)
+}
+
+func realParameter(name string) MethodParameter {
+	return MethodParameter{
+		Class:      RealClass,
+		Classes:    []*Class{IntClass, BoolClass, FractionClass, DecimalClass},
+		Name:       name,
+		IsNullable: false,
+		IsVariadic: false,
+	}
+}
+
+// realArgs converts arguments of realParameter to float64.
+func realArgs(ctx Context, args Tuple) ([]float64, error) {
+	values := make([]float64, len(args))
+	for i, arg := range args {
+		value, err := ToReal(ctx, arg)
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = float64(value.(Real))
+	}
+
+	return values, nil
+}
+
+// newDistributionMethod creates a method, which returns the real number
+// with the distribution. check returns an error if the parameters of
+// the distribution are wrong.
+func newDistributionMethod(
+	pkg *Package,
+	name string,
+	parameters []string,
+	check func(params []float64) error,
+	sample func(self *Generator, params []float64) float64,
+) *Method {
+	methodParameters := make([]MethodParameter, len(parameters))
+	for i, parameter := range parameters {
+		methodParameters[i] = realParameter(parameter)
+	}
+
+	return newGeneratorMethod(
+		pkg, name, methodParameters, RealClass,
+		func(ctx Context, self *Generator, args Tuple) (Object, error) {
+			params, err := realArgs(ctx, args)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, param := range params {
+				if math.IsNaN(param) || math.IsInf(param, 0) {
+					return nil, NewValueErrorf("параметри розподілу мають бути скінченними числами")
+				}
+			}
+
+			if err := check(params); err != nil {
+				return nil, err
+			}
+
+			return Real(sample(self, params)), nil
+		},
+	)
+}
+
+func checkPositive(names ...string) func(params []float64) error {
+	return func(params []float64) error {
+		for i, name := range names {
+			if params[i] <= 0 {
+				return NewValueErrorf("%s має бути додатним, отримано %v", name, params[i])
+			}
+		}
+
+		return nil
+	}
+}
+
+func checkNonNegative(name string, index int) func(params []float64) error {
+	return func(params []float64) error {
+		if params[index] < 0 {
+			return NewValueErrorf("%s не може бути від'ємним, отримано %v", name, params[index])
+		}
+
+		return nil
+	}
+}
+
+// sequenceElements returns elements of the list, the tuple or
+// characters of the string.
+func sequenceElements(obj Object) ([]Object, error) {
+	switch value := obj.(type) {
+	case *List:
+		return value.Values, nil
+	case *Tuple:
+		return *value, nil
+	case String:
+		elements := make([]Object, 0, utf8.RuneCountInString(string(value)))
+		for _, r := range value {
+			elements = append(elements, String(r))
+		}
+
+		return elements, nil
+	default:
+		return nil, NewTypeErrorf(
+			"очікується список, кортеж або рядок, отримано '%s'", obj.Class().Name,
+		)
+	}
+}
+
+func MakeGeneratorClassMethods(pkg *Package) StringDict {
+	methods := []*Method{
+		newGeneratorMethod(
+			pkg, "насіння", []MethodParameter{intParameter("насіння")}, NilClass,
+			func(ctx Context, self *Generator, args Tuple) (Object, error) {
+				seed, err := seedFromObject(ctx, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				if err := self.Seed(seed); err != nil {
+					return nil, err
+				}
+
+				return Nil, nil
+			},
+		),
+		newGeneratorMethod(
+			pkg, "ціле32", nil, IntClass,
+			func(_ Context, self *Generator, _ Tuple) (Object, error) {
+				return Int(self.Uint32()), nil
+			},
+		),
+		newGeneratorMethod(
+			pkg, "ціле31", nil, IntClass,
+			func(_ Context, self *Generator, _ Tuple) (Object, error) {
+				return Int(self.Uint32() >> 1), nil
+			},
+		),
+		newGeneratorMethod(
+			pkg, "дійсне1", nil, RealClass,
+			func(_ Context, self *Generator, _ Tuple) (Object, error) {
+				return Real(float64(self.Uint32()) * (1.0 / 4294967295.0)), nil
+			},
+		),
+		newGeneratorMethod(
+			pkg, "дійсне2", nil, RealClass,
+			func(_ Context, self *Generator, _ Tuple) (Object, error) {
+				return Real(float64(self.Uint32()) * (1.0 / 4294967296.0)), nil
+			},
+		),
+		newGeneratorMethod(
+			pkg, "дійсне3", nil, RealClass,
+			func(_ Context, self *Generator, _ Tuple) (Object, error) {
+				return Real((float64(self.Uint32()) + 0.5) * (1.0 / 4294967296.0)), nil
+			},
+		),
+		newGeneratorMethod(
+			pkg, "дійсне_розширене53", nil, RealClass,
+			func(_ Context, self *Generator, _ Tuple) (Object, error) {
+				return Real(self.Float53()), nil
+			},
+		),
+		newGeneratorMethod(
+			pkg, "випадкове", nil, RealClass,
+			func(_ Context, self *Generator, _ Tuple) (Object, error) {
+				return Real(self.Float53()), nil
+			},
+		),
+		newGeneratorMethod(
+			pkg, "ціле_між", []MethodParameter{intParameter("мінімум"), intParameter("максимум")}, IntClass,
+			func(ctx Context, self *Generator, args Tuple) (Object, error) {
+				min, err := ToGoInt(ctx, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				max, err := ToGoInt(ctx, args[1])
+				if err != nil {
+					return nil, err
+				}
+
+				n, err := self.IntBetween(int64(min), int64(max))
+				if err != nil {
+					return nil, err
+				}
+
+				return Int(n), nil
+			},
+		),
+		newDistributionMethod(
+			pkg, "дійсне_між", []string{"мінімум", "максимум"},
+			func(params []float64) error {
+				if params[0] > params[1] {
+					return NewValueErrorf("мінімум %v більший за максимум %v", params[0], params[1])
+				}
+
+				return nil
+			},
+			func(self *Generator, params []float64) float64 {
+				return params[0] + (params[1]-params[0])*self.Float53()
+			},
+		),
+		newGeneratorMethod(
+			pkg, "вибрати", []MethodParameter{objectParameter("послідовність")}, ObjectClass,
+			func(_ Context, self *Generator, args Tuple) (Object, error) {
+				elements, err := sequenceElements(args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				if len(elements) == 0 {
+					return nil, NewIndexOutOfRangeErrorf("неможливо вибрати елемент з порожньої послідовності")
+				}
+
+				return elements[self.Uint64n(uint64(len(elements)))], nil
+			},
+		),
+		newGeneratorMethod(
+			pkg,
+			"перемішати",
+			[]MethodParameter{
+				{
+					Class:      ListClass,
+					Classes:    nil,
+					Name:       "список",
+					IsNullable: false,
+					IsVariadic: false,
+				},
+			},
+			NilClass,
+			func(_ Context, self *Generator, args Tuple) (Object, error) {
+				self.Shuffle(args[0].(*List).Values)
+				return Nil, nil
+			},
+		),
+		newGeneratorMethod(
+			pkg,
+			"вибірка",
+			[]MethodParameter{objectParameter("послідовність"), intParameter("кількість")},
+			ListClass,
+			func(ctx Context, self *Generator, args Tuple) (Object, error) {
+				elements, err := sequenceElements(args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				k, err := ToGoInt(ctx, args[1])
+				if err != nil {
+					return nil, err
+				}
+
+				if k < 0 || k > len(elements) {
+					return nil, NewValueErrorf(
+						"кількість елементів вибірки має бути від 0 до %d, отримано %d", len(elements), k,
+					)
+				}
+
+				// The partial Fisher-Yates shuffle of the copy.
+				sample := append([]Object{}, elements...)
+				for i := 0; i < k; i++ {
+					j := i + int(self.Uint64n(uint64(len(sample)-i)))
+					sample[i], sample[j] = sample[j], sample[i]
+				}
+
+				list := NewList()
+				list.Values = sample[:k]
+				return list, nil
+			},
+		),
+		newDistributionMethod(
+			pkg, "нормальний", []string{"середнє", "відхилення"}, checkNonNegative("відхилення", 1),
+			func(self *Generator, params []float64) float64 {
+				return self.Normal(params[0], params[1])
+			},
+		),
+		newDistributionMethod(
+			pkg, "логнормальний", []string{"середнє", "відхилення"}, checkNonNegative("відхилення", 1),
+			func(self *Generator, params []float64) float64 {
+				return math.Exp(self.Normal(params[0], params[1]))
+			},
+		),
+		newDistributionMethod(
+			pkg, "експоненційний", []string{"інтенсивність"}, checkPositive("інтенсивність"),
+			func(self *Generator, params []float64) float64 {
+				return self.Exponential(params[0])
+			},
+		),
+		newDistributionMethod(
+			pkg, "гамма", []string{"форма", "масштаб"}, checkPositive("форма", "масштаб"),
+			func(self *Generator, params []float64) float64 {
+				return self.Gamma(params[0], params[1])
+			},
+		),
+		newDistributionMethod(
+			pkg, "бета", []string{"альфа", "бета"}, checkPositive("альфа", "бета"),
+			func(self *Generator, params []float64) float64 {
+				x := self.Gamma(params[0], 1)
+				return x / (x + self.Gamma(params[1], 1))
+			},
+		),
+		newDistributionMethod(
+			pkg, "трикутний", []string{"мінімум", "максимум", "мода"},
+			func(params []float64) error {
+				if params[0] > params[2] || params[2] > params[1] {
+					return NewValueErrorf("мода має бути між мінімумом і максимумом")
+				}
+
+				return nil
+			},
+			func(self *Generator, params []float64) float64 {
+				return self.Triangular(params[0], params[1], params[2])
+			},
+		),
+	}
+
+	dict := StringDict{}
+	for _, method := range methods {
+		dict[method.Name] = method
+	}
+
+	return dict
+}
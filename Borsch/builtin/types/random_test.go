@@ -0,0 +1,40 @@
+package types
+
+import (
+	"crypto/rand"
+	"errors"
+	"testing"
+)
+
+func TestGenerator_SecureSourceError(t *testing.T) {
+	readSecureRandom = func([]byte) (int, error) {
+		return 0, errors.New("entropy is not available")
+	}
+	defer func() {
+		readSecureRandom = rand.Read
+	}()
+
+	methods := MakeGeneratorClassMethods(nil)
+	generator := NewSecureGenerator()
+	for _, name := range []string{"ціле32", "випадкове", "гамма"} {
+		args := Tuple{generator}
+		if name == "гамма" {
+			args = append(args, Real(0.5), Real(1))
+		}
+
+		result, err := methods[name].(*Method).methodF(nil, args, nil)
+		if _, ok := err.(*IOError); !ok {
+			t.Errorf("Assertion failed for %s:\nActual:\n%v, %v\n\nExpected:\n*IOError", name, result, err)
+		}
+	}
+
+	// The seed falls back to the current time.
+	if _, ok := NewRandomGenerator().source.(*mt19937); !ok {
+		t.Error("the random generator must use MT19937")
+	}
+
+	readSecureRandom = rand.Read
+	if _, err := methods["ціле32"].(*Method).methodF(nil, Tuple{generator}, nil); err != nil {
+		t.Errorf("the error must not be kept after it is returned: %v", err)
+	}
+}
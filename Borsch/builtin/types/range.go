@@ -0,0 +1,230 @@
+package types
+
+import "fmt"
+
+var RangeClass = ObjectClass.ClassNew("діапазон", map[string]Object{}, true, RangeNew, nil)
+
+// Range is the arithmetic progression of integers from start up to,
+// but not including, stop. Its elements are computed on demand.
+type Range struct {
+	start Int
+	stop  Int
+	step  Int
+}
+
+// RangeNew creates the range with the stop, with the start and the
+// stop, or with the start, the stop and the step.
+func RangeNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	if len(args) == 0 || len(args) > 3 {
+		return nil, NewTypeErrorf("%s() приймає від 1 до 3 аргументів (отримано %d)", cls.Name, len(args))
+	}
+
+	bounds := make([]Int, len(args))
+	for i, arg := range args {
+		if _, ok := arg.(*BigInt); ok {
+			return nil, NewOverflowErrorf("%s() аргументи виходять за межі цілого числа", cls.Name)
+		}
+
+		bound, ok := arg.(Int)
+		if !ok {
+			return nil, NewTypeErrorf(
+				"%s() аргументи мають бути типу '%s', отримано '%s'", cls.Name, IntClass.Name, arg.Class().Name,
+			)
+		}
+
+		bounds[i] = bound
+	}
+
+	switch len(bounds) {
+	case 1:
+		return &Range{start: 0, stop: bounds[0], step: 1}, nil
+	case 2:
+		return &Range{start: bounds[0], stop: bounds[1], step: 1}, nil
+	default:
+		if bounds[2] == 0 {
+			return nil, NewValueErrorf("крок діапазону не може бути нульовим")
+		}
+
+		return &Range{start: bounds[0], stop: bounds[1], step: bounds[2]}, nil
+	}
+}
+
+func (value *Range) Class() *Class {
+	return RangeClass
+}
+
+func (value *Range) Length(_ Context) (Int, error) {
+	if value.step > 0 && value.start < value.stop {
+		return (value.stop - value.start + value.step - 1) / value.step, nil
+	}
+
+	if value.step < 0 && value.start > value.stop {
+		return (value.start - value.stop - value.step - 1) / -value.step, nil
+	}
+
+	return 0, nil
+}
+
+func (value *Range) GetElement(ctx Context, index Int) (Object, error) {
+	length, err := value.Length(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkIndex(index, length, "діапазону"); err != nil {
+		return nil, err
+	}
+
+	return value.start + index*value.step, nil
+}
+
+func (value *Range) SetElement(_ Context, _ Int, _ Object) (Object, error) {
+	return nil, NewTypeError("об'єкт з типом 'діапазон' не підтримує присвоєння елементів за індексом")
+}
+
+// Slice returns the range of elements from the left bound up to, but not
+// including, the right bound.
+func (value *Range) Slice(ctx Context, leftBound, rightBound Int) (Object, error) {
+	length, err := value.Length(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if leftBound < 0 {
+		leftBound = 0
+	}
+
+	if rightBound > length {
+		rightBound = length
+	}
+
+	if leftBound > length {
+		leftBound = length
+	}
+
+	if leftBound > rightBound {
+		rightBound = leftBound
+	}
+
+	return &Range{
+		start: value.start + leftBound*value.step,
+		stop:  value.start + rightBound*value.step,
+		step:  value.step,
+	}, nil
+}
+
+func (value *Range) represent(_ Context) (Object, error) {
+	if value.step == 1 {
+		return String(fmt.Sprintf("%s(%d, %d)", RangeClass.Name, value.start, value.stop)), nil
+	}
+
+	return String(fmt.Sprintf("%s(%d, %d, %d)", RangeClass.Name, value.start, value.stop, value.step)), nil
+}
+
+func (value *Range) string(ctx Context) (Object, error) {
+	return value.represent(ctx)
+}
+
+func (value *Range) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+func (value *Range) toBool(ctx Context) (Object, error) {
+	length, err := value.Length(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return gb2bo(length != 0), nil
+}
+
+func (value *Range) contains(ctx Context, item Object) (Object, error) {
+	n, ok := item.(Int)
+	if !ok {
+		return False, nil
+	}
+
+	length, err := value.Length(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if length == 0 || (n-value.start)%value.step != 0 {
+		return False, nil
+	}
+
+	index := (n - value.start) / value.step
+	return gb2bo(index >= 0 && index < length), nil
+}
+
+func (value *Range) iterate(ctx Context) (Object, error) {
+	length, err := value.Length(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rangeIterator{current: value.start, step: value.step, remaining: length}, nil
+}
+
+// equals compares elements of ranges, so all empty ranges are equal.
+func (value *Range) equals(ctx Context, other Object) (Object, error) {
+	o, ok := other.(*Range)
+	if !ok {
+		return False, nil
+	}
+
+	length, err := value.Length(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	otherLength, err := o.Length(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case length != otherLength:
+		return False, nil
+	case length == 0:
+		return True, nil
+	case length == 1:
+		return gb2bo(value.start == o.start), nil
+	default:
+		return gb2bo(value.start == o.start && value.step == o.step), nil
+	}
+}
+
+func (value *Range) notEquals(ctx Context, other Object) (Object, error) {
+	result, err := value.equals(ctx, other)
+	if err != nil {
+		return nil, err
+	}
+
+	return !result.(Bool), nil
+}
+
+type rangeIterator struct {
+	current   Int
+	step      Int
+	remaining Int
+}
+
+func (value *rangeIterator) Class() *Class {
+	return IteratorClass
+}
+
+func (value *rangeIterator) iterate(_ Context) (Object, error) {
+	return value, nil
+}
+
+func (value *rangeIterator) next(_ Context) (Object, error) {
+	if value.remaining == 0 {
+		return nil, NewStopIterationError()
+	}
+
+	current := value.current
+	value.current += value.step
+	value.remaining--
+	return current, nil
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"strconv"
 	"strings"
 )
@@ -78,11 +79,38 @@ func (value Real) toBool(Context) (Object, error) {
 // 	return value, nil
 // }
 
+func (value Real) hash(Context) (Object, error) {
+	// Equal numbers must have equal hashes, i.e. 1.0 and 1.
+	if i := int64(value); Real(i) == value {
+		return Int(i), nil
+	}
+
+	return Int(math.Float64bits(float64(value))), nil
+}
+
 func (value Real) toInt(ctx Context) (Object, error) {
-	return Int(value), nil
+	f := float64(value)
+	if math.IsNaN(f) {
+		return nil, NewValueErrorf("неможливо перетворити NaN у ціле число")
+	}
+
+	if math.IsInf(f, 0) {
+		return nil, NewOverflowErrorf("неможливо перетворити нескінченність у ціле число")
+	}
+
+	if f >= math.MinInt64 && f < math.MaxInt64 {
+		return Int(value), nil
+	}
+
+	x, _ := big.NewFloat(f).Int(nil)
+	return newBigInt(x), nil
 }
 
-func (value Real) add(_ Context, other Object) (Object, error) {
+func (value Real) add(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedAdd(ctx, value)
+	}
+
 	if otherValue, ok := other.(Real); ok {
 		return value + otherValue, nil
 	}
@@ -95,10 +123,14 @@ func (value Real) add(_ Context, other Object) (Object, error) {
 		return value + bo2ro(otherValue), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати додавання дійсного числа до об'єкта '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Real) reversedAdd(_ Context, other Object) (Object, error) {
+func (value Real) reversedAdd(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.add(ctx, value)
+	}
+
 	if otherValue, ok := other.(Real); ok {
 		return otherValue + value, nil
 	}
@@ -111,10 +143,14 @@ func (value Real) reversedAdd(_ Context, other Object) (Object, error) {
 		return bo2ro(otherValue) + value, nil
 	}
 
-	return nil, NewErrorf("неможливо виконати додавання об'єкта '%s' до дійсне число", other.Class().Name)
+	return nil, nil
 }
 
-func (value Real) sub(_ Context, other Object) (Object, error) {
+func (value Real) sub(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedSub(ctx, value)
+	}
+
 	if otherValue, ok := other.(Real); ok {
 		return value - otherValue, nil
 	}
@@ -127,10 +163,14 @@ func (value Real) sub(_ Context, other Object) (Object, error) {
 		return value - bo2ro(otherValue), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати віднімання дійсного числа від об'єкта '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Real) reversedSub(_ Context, other Object) (Object, error) {
+func (value Real) reversedSub(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.sub(ctx, value)
+	}
+
 	if otherValue, ok := other.(Real); ok {
 		return otherValue - value, nil
 	}
@@ -143,10 +183,14 @@ func (value Real) reversedSub(_ Context, other Object) (Object, error) {
 		return bo2ro(otherValue) - value, nil
 	}
 
-	return nil, NewErrorf("неможливо виконати віднімання об'єкта '%s' від дійсне число", other.Class().Name)
+	return nil, nil
 }
 
-func (value Real) div(_ Context, other Object) (Object, error) {
+func (value Real) div(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedDiv(ctx, value)
+	}
+
 	if otherValue, ok := other.(Real); ok {
 		if otherValue == 0 {
 			return nil, NewZeroDivisionError("ділення на нуль")
@@ -171,10 +215,14 @@ func (value Real) div(_ Context, other Object) (Object, error) {
 		return value, nil
 	}
 
-	return nil, NewErrorf("неможливо виконати ділення дійсного числа на об'єкт '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Real) reversedDiv(_ Context, other Object) (Object, error) {
+func (value Real) reversedDiv(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.div(ctx, value)
+	}
+
 	if otherValue, ok := other.(Real); ok {
 		if value == 0 {
 			return nil, NewZeroDivisionError("ділення на нуль")
@@ -203,10 +251,14 @@ func (value Real) reversedDiv(_ Context, other Object) (Object, error) {
 		return 1.0 / value, nil
 	}
 
-	return nil, NewErrorf("неможливо виконати ділення об'єкта '%s' на дійсне число", other.Class().Name)
+	return nil, nil
 }
 
-func (value Real) mul(_ Context, other Object) (Object, error) {
+func (value Real) mul(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedMul(ctx, value)
+	}
+
 	if otherValue, ok := other.(Real); ok {
 		return value * otherValue, nil
 	}
@@ -223,10 +275,14 @@ func (value Real) mul(_ Context, other Object) (Object, error) {
 		return Real(0.0), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати множення дійсного числа на об'єкт '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Real) reversedMul(_ Context, other Object) (Object, error) {
+func (value Real) reversedMul(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.mul(ctx, value)
+	}
+
 	if otherValue, ok := other.(Real); ok {
 		return otherValue * value, nil
 	}
@@ -243,10 +299,14 @@ func (value Real) reversedMul(_ Context, other Object) (Object, error) {
 		return value, nil
 	}
 
-	return nil, NewErrorf("неможливо виконати множення об'єкта '%s' на дійсне число", other.Class().Name)
+	return nil, nil
 }
 
-func (value Real) mod(_ Context, other Object) (Object, error) {
+func (value Real) mod(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedMod(ctx, value)
+	}
+
 	if otherValue, ok := other.(Real); ok {
 		return mod(value, otherValue), nil
 	}
@@ -263,10 +323,14 @@ func (value Real) mod(_ Context, other Object) (Object, error) {
 		return mod(value, bo2ro(otherValue)), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати модуль? дійсного числа  '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Real) reversedMod(_ Context, other Object) (Object, error) {
+func (value Real) reversedMod(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.mod(ctx, value)
+	}
+
 	if otherValue, ok := other.(Real); ok {
 		return mod(otherValue, value), nil
 	}
@@ -283,10 +347,14 @@ func (value Real) reversedMod(_ Context, other Object) (Object, error) {
 		return mod(bo2ro(otherValue), value), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати модуль? об'єкта '%s'  дійсне число", other.Class().Name)
+	return nil, nil
 }
 
-func (value Real) pow(_ Context, other Object) (Object, error) {
+func (value Real) pow(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.reversedPow(ctx, value)
+	}
+
 	if otherValue, ok := other.(Real); ok {
 		return Real(math.Pow(float64(value), float64(otherValue))), nil
 	}
@@ -303,10 +371,14 @@ func (value Real) pow(_ Context, other Object) (Object, error) {
 		return Real(1.0), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати степінь? дійсного числа  '%s'", other.Class().Name)
+	return nil, nil
 }
 
-func (value Real) reversedPow(_ Context, other Object) (Object, error) {
+func (value Real) reversedPow(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.pow(ctx, value)
+	}
+
 	if otherValue, ok := other.(Real); ok {
 		return Real(math.Pow(float64(otherValue), float64(value))), nil
 	}
@@ -336,10 +408,14 @@ func (value Real) reversedPow(_ Context, other Object) (Object, error) {
 		return Real(0.0), nil
 	}
 
-	return nil, NewErrorf("неможливо виконати степінь? об'єкта '%s'  дійсне число", other.Class().Name)
+	return nil, nil
 }
 
-func (value Real) equals(_ Context, other Object) (Object, error) {
+func (value Real) equals(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.equals(ctx, value)
+	}
+
 	if v, ok := other.(Real); ok {
 		return goBoolToBoolObject(value == v), nil
 	}
@@ -352,10 +428,14 @@ func (value Real) equals(_ Context, other Object) (Object, error) {
 		return gb2bo(value == bo2ro(v)), nil
 	}
 
-	return False, nil
+	return nil, nil
 }
 
-func (value Real) notEquals(_ Context, other Object) (Object, error) {
+func (value Real) notEquals(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.notEquals(ctx, value)
+	}
+
 	if v, ok := other.(Real); ok {
 		return goBoolToBoolObject(value != v), nil
 	}
@@ -368,10 +448,14 @@ func (value Real) notEquals(_ Context, other Object) (Object, error) {
 		return gb2bo(value != bo2ro(v)), nil
 	}
 
-	return False, nil
+	return nil, nil
 }
 
-func (value Real) less(_ Context, other Object) (Object, error) {
+func (value Real) less(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.greater(ctx, value)
+	}
+
 	if v, ok := other.(Real); ok {
 		return goBoolToBoolObject(value < v), nil
 	}
@@ -384,10 +468,14 @@ func (value Real) less(_ Context, other Object) (Object, error) {
 		return gb2bo(value < bo2ro(v)), nil
 	}
 
-	return nil, OperatorNotSupportedErrorNew("<", value.Class().Name, other.Class().Name)
+	return unorderable("<", value, other)
 }
 
-func (value Real) lessOrEquals(_ Context, other Object) (Object, error) {
+func (value Real) lessOrEquals(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.greaterOrEquals(ctx, value)
+	}
+
 	if v, ok := other.(Real); ok {
 		return goBoolToBoolObject(value <= v), nil
 	}
@@ -400,10 +488,14 @@ func (value Real) lessOrEquals(_ Context, other Object) (Object, error) {
 		return gb2bo(value <= bo2ro(v)), nil
 	}
 
-	return nil, OperatorNotSupportedErrorNew("<=", value.Class().Name, other.Class().Name)
+	return unorderable("<=", value, other)
 }
 
-func (value Real) greater(_ Context, other Object) (Object, error) {
+func (value Real) greater(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.less(ctx, value)
+	}
+
 	if v, ok := other.(Real); ok {
 		return goBoolToBoolObject(value > v), nil
 	}
@@ -416,10 +508,14 @@ func (value Real) greater(_ Context, other Object) (Object, error) {
 		return gb2bo(value > bo2ro(v)), nil
 	}
 
-	return nil, OperatorNotSupportedErrorNew(">", value.Class().Name, other.Class().Name)
+	return unorderable(">", value, other)
 }
 
-func (value Real) greaterOrEquals(_ Context, other Object) (Object, error) {
+func (value Real) greaterOrEquals(ctx Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*BigInt); ok {
+		return otherValue.lessOrEquals(ctx, value)
+	}
+
 	if v, ok := other.(Real); ok {
 		return goBoolToBoolObject(value >= v), nil
 	}
@@ -432,7 +528,7 @@ func (value Real) greaterOrEquals(_ Context, other Object) (Object, error) {
 		return gb2bo(value >= bo2ro(v)), nil
 	}
 
-	return nil, OperatorNotSupportedErrorNew(">=", value.Class().Name, other.Class().Name)
+	return unorderable(">=", value, other)
 }
 
 func (value Real) positive(_ Context) (Object, error) {
@@ -0,0 +1,60 @@
+package types
+
+// recursionGuard keeps track of containers which are being processed
+// by a recursive operation, so cyclic references could be detected
+// instead of falling into infinite recursion.
+type recursionGuard map[[2]Object]struct{}
+
+var (
+	comparisonGuard     = recursionGuard{}
+	representationGuard = recursionGuard{}
+)
+
+// enter marks the pair of objects as being processed and returns
+// false if the pair is already marked, i.e. the cycle is found.
+func (guard recursionGuard) enter(a, b Object) bool {
+	key := [2]Object{a, b}
+	if _, ok := guard[key]; ok {
+		return false
+	}
+
+	guard[key] = struct{}{}
+	return true
+}
+
+func (guard recursionGuard) leave(a, b Object) {
+	delete(guard, [2]Object{a, b})
+}
+
+// compareContainers compares two containers using the compare
+// function. The containers which are compared again while their
+// comparison is in progress have a cycle and are considered equal
+// at that point.
+func compareContainers(a, b Object, compare func() (int, error)) (int, error) {
+	if a == b {
+		return 0, nil
+	}
+
+	if !comparisonGuard.enter(a, b) {
+		return 0, nil
+	}
+
+	defer comparisonGuard.leave(a, b)
+	return compare()
+}
+
+// representContainer builds the string representation of the
+// container using the represent function, or returns the placeholder
+// if the container is already being represented.
+func representContainer(
+	container Object,
+	placeholder String,
+	represent func() (Object, error),
+) (Object, error) {
+	if !representationGuard.enter(container, nil) {
+		return placeholder, nil
+	}
+
+	defer representationGuard.leave(container, nil)
+	return represent()
+}
@@ -0,0 +1,464 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	RegexpClass = ObjectClass.ClassNew("регулярний_вираз", map[string]Object{}, true, RegexpNew, nil)
+	MatchClass  = ObjectClass.ClassNew("збіг", map[string]Object{}, true, nil, nil)
+)
+
+// Regexp is a compiled regular expression with the syntax of RE2.
+// Positions of matches are counted in characters of the string, the
+// same as indices of strings.
+type Regexp struct {
+	expr   string
+	regexp *regexp.Regexp
+
+	// anchored matches only at the start of the string.
+	anchored *regexp.Regexp
+
+	// groupNames are names of groups by their numbers, an empty string
+	// for unnamed groups.
+	groupNames []string
+}
+
+// renameGroups replaces names of groups "(?P<назва>" and "(?<назва>"
+// with ASCII names, since RE2 doesn't allow letters of other alphabets
+// in them. Original names are returned in the order of groups.
+func renameGroups(expr string) (string, []string, error) {
+	var builder strings.Builder
+	var names []string
+	inClass := false
+	for i := 0; i < len(expr); {
+		switch {
+		case expr[i] == '\\':
+			_, size := utf8.DecodeRuneInString(expr[i+1:])
+			builder.WriteString(expr[i : i+1+size])
+			i += 1 + size
+			continue
+		case inClass:
+			// ']' right after '[' or '[^' is a character of the class.
+			if expr[i] == ']' && !strings.HasSuffix(builder.String(), "[") && !strings.HasSuffix(builder.String(), "[^") {
+				inClass = false
+			}
+		case expr[i] == '[':
+			inClass = true
+		case strings.HasPrefix(expr[i:], "(?P<") || strings.HasPrefix(expr[i:], "(?<"):
+			start := strings.IndexByte(expr[i:], '<') + i + 1
+			end := strings.IndexByte(expr[start:], '>')
+			if end < 0 {
+				return "", nil, NewValueErrorf("некоректний регулярний вираз '%s': назва групи не закрита", expr)
+			}
+
+			name := expr[start : start+end]
+			if !isGroupName(name) {
+				return "", nil, NewValueErrorf("некоректний регулярний вираз '%s': некоректна назва групи '%s'", expr, name)
+			}
+
+			builder.WriteString(fmt.Sprintf("(?P<g%d>", len(names)))
+			names = append(names, name)
+			i = start + end + 1
+			continue
+		}
+
+		builder.WriteByte(expr[i])
+		i++
+	}
+
+	return builder.String(), names, nil
+}
+
+func isGroupName(name string) bool {
+	for _, r := range name {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+
+	return name != ""
+}
+
+// CompileRegexp compiles the expression, the syntax error is returned
+// as ValueError.
+func CompileRegexp(expr string) (*Regexp, error) {
+	renamed, names, err := renameGroups(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	re, err := regexp.Compile(renamed)
+	if err != nil {
+		return nil, NewValueErrorf("некоректний регулярний вираз '%s': %s", expr, syntaxErrorMessage(err))
+	}
+
+	groupNames := re.SubexpNames()
+	for i, name := range groupNames {
+		if name != "" {
+			n, _ := strconv.Atoi(name[1:])
+			groupNames[i] = names[n]
+		}
+	}
+
+	return &Regexp{
+		expr:       expr,
+		regexp:     re,
+		anchored:   regexp.MustCompile(`^(?:` + renamed + `)`),
+		groupNames: groupNames,
+	}, nil
+}
+
+// syntaxErrorMessages translates codes of syntax errors of RE2.
+var syntaxErrorMessages = map[syntax.ErrorCode]string{
+	syntax.ErrInternalError:         "внутрішня помилка",
+	syntax.ErrInvalidCharClass:      "некоректний клас символів",
+	syntax.ErrInvalidCharRange:      "некоректний діапазон класу символів",
+	syntax.ErrInvalidEscape:         "некоректна екранована послідовність",
+	syntax.ErrInvalidNamedCapture:   "некоректна іменована група",
+	syntax.ErrInvalidPerlOp:         "некоректний або непідтримуваний синтаксис Perl",
+	syntax.ErrInvalidRepeatOp:       "некоректний вкладений оператор повторення",
+	syntax.ErrInvalidRepeatSize:     "некоректна кількість повторень",
+	syntax.ErrInvalidUTF8:           "некоректний UTF-8",
+	syntax.ErrMissingBracket:        "відсутня закриваюча дужка ']'",
+	syntax.ErrMissingParen:          "відсутня закриваюча дужка ')'",
+	syntax.ErrMissingRepeatArgument: "відсутній аргумент оператора повторення",
+	syntax.ErrTrailingBackslash:     "зворотна скісна риска в кінці виразу",
+	syntax.ErrUnexpectedParen:       "неочікувана дужка ')'",
+	"expression nests too deeply":   "занадто глибока вкладеність виразу",
+	"expression too large":          "занадто великий вираз",
+}
+
+// syntaxErrorMessage drops the expression from the message of the error,
+// since it is already in the message of ValueError.
+func syntaxErrorMessage(err error) string {
+	if syntaxErr, ok := err.(*syntax.Error); ok {
+		if message, ok := syntaxErrorMessages[syntaxErr.Code]; ok {
+			return message
+		}
+
+		return string(syntaxErr.Code)
+	}
+
+	return err.Error()
+}
+
+func RegexpNew(_ Context, cls *Class, args Tuple) (Object, error) {
+	if len(args) != 1 {
+		return nil, NewTypeErrorf("%s() приймає 1 аргумент (отримано %d)", cls.Name, len(args))
+	}
+
+	expr, ok := args[0].(String)
+	if !ok {
+		return nil, NewTypeErrorf("очікується аргумент з типом ʼ%sʼ, отримано ʼ%sʼ", StringClass.Name, args[0].Class().Name)
+	}
+
+	return CompileRegexp(string(expr))
+}
+
+// ToRegexp returns the regular expression or compiles the string.
+func ToRegexp(obj Object) (*Regexp, error) {
+	switch value := obj.(type) {
+	case *Regexp:
+		return value, nil
+	case String:
+		return CompileRegexp(string(value))
+	default:
+		return nil, NewTypeErrorf(
+			"очікується аргумент з одним із типів ʼ%s, %sʼ, отримано ʼ%sʼ",
+			RegexpClass.Name,
+			StringClass.Name,
+			obj.Class().Name,
+		)
+	}
+}
+
+func (value *Regexp) Class() *Class {
+	return RegexpClass
+}
+
+func (value *Regexp) represent(ctx Context) (Object, error) {
+	expr, err := String(value.expr).represent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return String(fmt.Sprintf("%s(%s)", RegexpClass.Name, expr)), nil
+}
+
+func (value *Regexp) string(ctx Context) (Object, error) {
+	return value.represent(ctx)
+}
+
+func (value *Regexp) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+func (value *Regexp) equals(_ Context, other Object) (Object, error) {
+	if otherValue, ok := other.(*Regexp); ok {
+		return gb2bo(value.expr == otherValue.expr), nil
+	}
+
+	return nil, nil
+}
+
+func (value *Regexp) hash(ctx Context) (Object, error) {
+	return String(value.expr).hash(ctx)
+}
+
+// runeCounter converts offsets in bytes of the text to offsets in
+// characters. Offsets are expected to grow, so the text is counted only
+// once while iterating over matches.
+type runeCounter struct {
+	text       string
+	byteOffset int
+	runeOffset int
+}
+
+func (o *runeCounter) at(byteOffset int) int {
+	if byteOffset < o.byteOffset {
+		o.byteOffset, o.runeOffset = 0, 0
+	}
+
+	o.runeOffset += utf8.RuneCountInString(o.text[o.byteOffset:byteOffset])
+	o.byteOffset = byteOffset
+	return o.runeOffset
+}
+
+func (value *Regexp) newMatch(text string, indexes []int, counter *runeCounter) *Match {
+	positions := make([]int, len(indexes))
+	start := counter.at(indexes[0])
+	for i, index := range indexes {
+		if index < 0 {
+			positions[i] = -1
+		} else {
+			positions[i] = start + utf8.RuneCountInString(text[indexes[0]:index])
+		}
+	}
+
+	return &Match{regexp: value, text: text, indexes: indexes, positions: positions}
+}
+
+// Match finds the match at the start of the text, Nil is returned if
+// there is none.
+func (value *Regexp) Match(text string) Object {
+	indexes := value.anchored.FindStringSubmatchIndex(text)
+	if indexes == nil {
+		return Nil
+	}
+
+	return value.newMatch(text, indexes, &runeCounter{text: text})
+}
+
+// Search finds the first match anywhere in the text, Nil is returned if
+// there is none.
+func (value *Regexp) Search(text string) Object {
+	indexes := value.regexp.FindStringSubmatchIndex(text)
+	if indexes == nil {
+		return Nil
+	}
+
+	return value.newMatch(text, indexes, &runeCounter{text: text})
+}
+
+// FindAll returns the list of all non-overlapping matches.
+func (value *Regexp) FindAll(text string) *List {
+	list := NewList()
+	counter := &runeCounter{text: text}
+	for _, indexes := range value.regexp.FindAllStringSubmatchIndex(text, -1) {
+		list.Values = append(list.Values, value.newMatch(text, indexes, counter))
+	}
+
+	return list
+}
+
+// Split returns the list of parts of the text between matches.
+func (value *Regexp) Split(text string) *List {
+	list := NewList()
+	for _, part := range value.regexp.Split(text, -1) {
+		list.Values = append(list.Values, String(part))
+	}
+
+	return list
+}
+
+// groupIndex returns the number of the group with the name, which is
+// a number or a name of the group.
+func (value *Regexp) groupIndex(name string) (int, error) {
+	if i, err := strconv.Atoi(name); err == nil {
+		if i < 0 || i >= len(value.groupNames) {
+			return 0, NewIndexOutOfRangeErrorf("немає групи з номером %d", i)
+		}
+
+		return i, nil
+	}
+
+	for i, groupName := range value.groupNames {
+		if groupName == name {
+			return i, nil
+		}
+	}
+
+	return 0, NewIndexOutOfRangeErrorf("немає групи з назвою '%s'", name)
+}
+
+// expand writes the template replacing "$1", "${1}", "$назва" and
+// "${назва}" with the text of the group and "$$" with '$'.
+func (value *Regexp) expand(builder *strings.Builder, template, text string, indexes []int) error {
+	for len(template) > 0 {
+		i := strings.IndexByte(template, '$')
+		if i < 0 {
+			builder.WriteString(template)
+			break
+		}
+
+		builder.WriteString(template[:i])
+		template = template[i+1:]
+		if strings.HasPrefix(template, "$") {
+			builder.WriteByte('$')
+			template = template[1:]
+			continue
+		}
+
+		var name string
+		if strings.HasPrefix(template, "{") {
+			end := strings.IndexByte(template, '}')
+			if end < 0 {
+				return NewValueErrorf("не закрита назва групи у рядку заміни")
+			}
+
+			name, template = template[1:end], template[end+1:]
+		} else {
+			end := strings.IndexFunc(
+				template, func(r rune) bool {
+					return r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+				},
+			)
+			if end < 0 {
+				end = len(template)
+			}
+
+			name, template = template[:end], template[end:]
+		}
+
+		if name == "" {
+			return NewValueErrorf("очікується назва групи після '$' у рядку заміни")
+		}
+
+		group, err := value.groupIndex(name)
+		if err != nil {
+			return err
+		}
+
+		if indexes[2*group] >= 0 {
+			builder.WriteString(text[indexes[2*group]:indexes[2*group+1]])
+		}
+	}
+
+	return nil
+}
+
+// Replace replaces all matches with the replacement. The string is
+// expanded by expand, otherwise the replacement is called with the
+// match and has to return the string.
+func (value *Regexp) Replace(ctx Context, text string, replacement Object) (Object, error) {
+	var builder strings.Builder
+	counter := &runeCounter{text: text}
+	last := 0
+	for _, indexes := range value.regexp.FindAllStringSubmatchIndex(text, -1) {
+		builder.WriteString(text[last:indexes[0]])
+		last = indexes[1]
+		if template, ok := replacement.(String); ok {
+			if err := value.expand(&builder, string(template), text, indexes); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		result, err := Call(ctx, replacement, Tuple{value.newMatch(text, indexes, counter)})
+		if err != nil {
+			return nil, err
+		}
+
+		s, ok := result.(String)
+		if !ok {
+			return nil, NewTypeErrorf("функція заміни має повертати ʼ%sʼ, отримано ʼ%sʼ", StringClass.Name, result.Class().Name)
+		}
+
+		builder.WriteString(string(s))
+	}
+
+	builder.WriteString(text[last:])
+	return String(builder.String()), nil
+}
+
+// Match is a match of the regular expression in the text. Positions of
+// groups are in characters, a group, which did not participate in the
+// match, has no text and position -1.
+type Match struct {
+	regexp    *Regexp
+	text      string
+	indexes   []int
+	positions []int
+}
+
+func (value *Match) Class() *Class {
+	return MatchClass
+}
+
+// group returns the number of the group by its number or name.
+func (value *Match) group(ctx Context, obj Object) (int, error) {
+	if name, ok := obj.(String); ok {
+		return value.regexp.groupIndex(string(name))
+	}
+
+	i, err := ToGoInt(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+
+	if i < 0 || i > value.regexp.regexp.NumSubexp() {
+		return 0, NewIndexOutOfRangeErrorf("немає групи з номером %d", i)
+	}
+
+	return i, nil
+}
+
+// Group returns the text of the group or Nil.
+func (value *Match) Group(i int) Object {
+	if value.indexes[2*i] < 0 {
+		return Nil
+	}
+
+	return String(value.text[value.indexes[2*i]:value.indexes[2*i+1]])
+}
+
+// Span returns the start and the end of the group in characters.
+func (value *Match) Span(i int) (int, int) {
+	return value.positions[2*i], value.positions[2*i+1]
+}
+
+func (value *Match) represent(ctx Context) (Object, error) {
+	text, err := String(value.text[value.indexes[0]:value.indexes[1]]).represent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := value.Span(0)
+	return String(fmt.Sprintf("<%s (%d, %d): %s>", MatchClass.Name, start, end, text)), nil
+}
+
+func (value *Match) string(ctx Context) (Object, error) {
+	return value.represent(ctx)
+}
+
+func (value *Match) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
@@ -0,0 +1,246 @@
+package types
+
+type regexpMethodFunc func(ctx Context, self *Regexp, args Tuple) (Object, error)
+
+// newRegexpMethod creates a method of 'регулярний_вираз' class, the
+// first parameter of which is the expression itself.
+func newRegexpMethod(
+	pkg *Package,
+	name string,
+	parameters []MethodParameter,
+	returnType *Class,
+	f regexpMethodFunc,
+) *Method {
+	return MethodNew(
+		name,
+		pkg,
+		append(
+			[]MethodParameter{
+				{
+					Class:      RegexpClass,
+					Classes:    nil,
+					Name:       "я",
+					IsNullable: false,
+					IsVariadic: false,
+				},
+			},
+			parameters...,
+		),
+		[]MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: false,
+			},
+		},
+		func(ctx Context, args Tuple, _ StringDict) (Object, error) {
+			return f(ctx, args[0].(*Regexp), args[1:])
+		},
+	)
+}
+
+func MakeRegexpClassMethods(pkg *Package) StringDict {
+	methods := []*Method{
+		newRegexpMethod(
+			pkg, "зіставити", []MethodParameter{stringParameter("рядок")}, ObjectClass,
+			func(_ Context, self *Regexp, args Tuple) (Object, error) {
+				return self.Match(string(args[0].(String))), nil
+			},
+		),
+		newRegexpMethod(
+			pkg, "знайти", []MethodParameter{stringParameter("рядок")}, ObjectClass,
+			func(_ Context, self *Regexp, args Tuple) (Object, error) {
+				return self.Search(string(args[0].(String))), nil
+			},
+		),
+		newRegexpMethod(
+			pkg, "знайти_всі", []MethodParameter{stringParameter("рядок")}, ListClass,
+			func(_ Context, self *Regexp, args Tuple) (Object, error) {
+				return self.FindAll(string(args[0].(String))), nil
+			},
+		),
+		newRegexpMethod(
+			pkg, "розділити", []MethodParameter{stringParameter("рядок")}, ListClass,
+			func(_ Context, self *Regexp, args Tuple) (Object, error) {
+				return self.Split(string(args[0].(String))), nil
+			},
+		),
+		newRegexpMethod(
+			pkg,
+			"замінити",
+			[]MethodParameter{stringParameter("рядок"), objectParameter("заміна")},
+			StringClass,
+			func(ctx Context, self *Regexp, args Tuple) (Object, error) {
+				return self.Replace(ctx, string(args[0].(String)), args[1])
+			},
+		),
+		newRegexpMethod(
+			pkg, "шаблон", nil, StringClass,
+			func(_ Context, self *Regexp, _ Tuple) (Object, error) {
+				return String(self.expr), nil
+			},
+		),
+		newRegexpMethod(
+			pkg, "кількість_груп", nil, IntClass,
+			func(_ Context, self *Regexp, _ Tuple) (Object, error) {
+				return Int(self.regexp.NumSubexp()), nil
+			},
+		),
+		newRegexpMethod(
+			pkg, "назви_груп", nil, ListClass,
+			func(_ Context, self *Regexp, _ Tuple) (Object, error) {
+				list := NewList()
+				for _, name := range self.groupNames {
+					if name != "" {
+						list.Values = append(list.Values, String(name))
+					}
+				}
+
+				return list, nil
+			},
+		),
+	}
+
+	dict := StringDict{}
+	for _, method := range methods {
+		dict[method.Name] = method
+	}
+
+	return dict
+}
+
+type matchMethodFunc func(ctx Context, self *Match, args Tuple) (Object, error)
+
+// newMatchMethod creates a method of 'збіг' class, the first parameter
+// of which is the match itself.
+func newMatchMethod(
+	pkg *Package,
+	name string,
+	parameters []MethodParameter,
+	returnType *Class,
+	f matchMethodFunc,
+) *Method {
+	return MethodNew(
+		name,
+		pkg,
+		append(
+			[]MethodParameter{
+				{
+					Class:      MatchClass,
+					Classes:    nil,
+					Name:       "я",
+					IsNullable: false,
+					IsVariadic: false,
+				},
+			},
+			parameters...,
+		),
+		[]MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: false,
+			},
+		},
+		func(ctx Context, args Tuple, _ StringDict) (Object, error) {
+			return f(ctx, args[0].(*Match), args[1:])
+		},
+	)
+}
+
+// groupParameter is the optional number or name of the group, the whole
+// match is the group 0, which is used if the group is not given.
+func groupParameter() MethodParameter {
+	return MethodParameter{
+		Class:      IntClass,
+		Classes:    []*Class{StringClass},
+		Name:       "група",
+		IsNullable: false,
+		IsVariadic: true,
+	}
+}
+
+// newMatchGroupMethod creates a method of 'збіг' class, which returns
+// the property of the group.
+func newMatchGroupMethod(pkg *Package, name string, returnType *Class, f func(self *Match, i int) Object) *Method {
+	return newMatchMethod(
+		pkg, name, []MethodParameter{groupParameter()}, returnType,
+		func(ctx Context, self *Match, args Tuple) (Object, error) {
+			groups := *args[0].(*Tuple)
+			if len(groups) > 1 {
+				return nil, NewTypeErrorf(
+					"метод '%s' приймає не більше 1 необов'язкового аргументу (отримано %d)", name, len(groups),
+				)
+			}
+
+			var group Object = Int(0)
+			if len(groups) != 0 {
+				group = groups[0]
+			}
+
+			i, err := self.group(ctx, group)
+			if err != nil {
+				return nil, err
+			}
+
+			return f(self, i), nil
+		},
+	)
+}
+
+func MakeMatchClassMethods(pkg *Package) StringDict {
+	methods := []*Method{
+		newMatchGroupMethod(pkg, "група", ObjectClass, (*Match).Group),
+		newMatchGroupMethod(
+			pkg, "початок", IntClass, func(self *Match, i int) Object {
+				start, _ := self.Span(i)
+				return Int(start)
+			},
+		),
+		newMatchGroupMethod(
+			pkg, "закінчення", IntClass, func(self *Match, i int) Object {
+				_, end := self.Span(i)
+				return Int(end)
+			},
+		),
+		newMatchGroupMethod(
+			pkg, "проміжок", TupleClass, func(self *Match, i int) Object {
+				start, end := self.Span(i)
+				return &Tuple{Int(start), Int(end)}
+			},
+		),
+		newMatchMethod(
+			pkg, "групи", nil, ListClass,
+			func(_ Context, self *Match, _ Tuple) (Object, error) {
+				list := NewList()
+				for i := 1; i <= self.regexp.regexp.NumSubexp(); i++ {
+					list.Values = append(list.Values, self.Group(i))
+				}
+
+				return list, nil
+			},
+		),
+		newMatchMethod(
+			pkg, "іменовані_групи", nil, DictionaryClass,
+			func(ctx Context, self *Match, _ Tuple) (Object, error) {
+				dict := NewDictionary()
+				for i, name := range self.regexp.groupNames {
+					if name == "" {
+						continue
+					}
+
+					if _, err := dict.SetItem(ctx, String(name), self.Group(i)); err != nil {
+						return nil, err
+					}
+				}
+
+				return dict, nil
+			},
+		),
+	}
+
+	dict := StringDict{}
+	for _, method := range methods {
+		dict[method.Name] = method
+	}
+
+	return dict
+}
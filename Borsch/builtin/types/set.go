@@ -0,0 +1,340 @@
+package types
+
+import "fmt"
+
+var (
+	SetClass       = ObjectClass.ClassNew("множина", map[string]Object{}, true, SetNew, nil)
+	FrozenSetClass = ObjectClass.ClassNew("незмінна_множина", map[string]Object{}, true, FrozenSetNew, nil)
+)
+
+// Set is an unordered collection of unique hashable elements.
+// The elements are iterated in the insertion order. A frozen set
+// can not be changed after creation, so it is hashable itself.
+type Set struct {
+	// elements stores the elements as keys, values are not used.
+	elements *Dictionary
+	frozen   bool
+}
+
+func NewSet() *Set {
+	return &Set{elements: NewDictionary(), frozen: false}
+}
+
+func NewFrozenSet() *Set {
+	return &Set{elements: NewDictionary(), frozen: true}
+}
+
+func (value *Set) Class() *Class {
+	if value.frozen {
+		return FrozenSetClass
+	}
+
+	return SetClass
+}
+
+func SetNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	return fillSet(ctx, cls, NewSet(), args)
+}
+
+func FrozenSetNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	return fillSet(ctx, cls, NewFrozenSet(), args)
+}
+
+// fillSet adds elements of the optional iterable argument to the set.
+func fillSet(ctx Context, cls *Class, set *Set, args Tuple) (Object, error) {
+	switch len(args) {
+	case 0:
+		return set, nil
+	case 1:
+		err := IterateOver(
+			ctx, args[0], func(element Object) (bool, error) {
+				return false, set.Add(ctx, element)
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return set, nil
+	default:
+		return nil, NewTypeErrorf("%s() приймає не більше 1 аргументу (отримано %d)", cls.Name, len(args))
+	}
+}
+
+// newSetLike creates an empty set of the same kind as the given set.
+func newSetLike(set *Set) *Set {
+	return &Set{elements: NewDictionary(), frozen: set.frozen}
+}
+
+func (value *Set) Add(ctx Context, element Object) error {
+	_, err := value.elements.SetItem(ctx, element, Nil)
+	return err
+}
+
+func (value *Set) has(ctx Context, element Object) (bool, error) {
+	_, index, err := value.elements.find(ctx, element)
+	return index != -1, err
+}
+
+// Elements returns elements of the set in the insertion order.
+func (value *Set) Elements() []Object {
+	return value.elements.Keys()
+}
+
+func (value *Set) Length(ctx Context) (Int, error) {
+	return value.elements.Length(ctx)
+}
+
+func (value *Set) represent(ctx Context) (Object, error) {
+	return value.string(ctx)
+}
+
+func (value *Set) string(ctx Context) (Object, error) {
+	if len(value.elements.entries) == 0 {
+		return String(fmt.Sprintf("%s()", value.Class().Name)), nil
+	}
+
+	return representContainer(
+		value, "{...}", func() (Object, error) {
+			str := String("")
+			for i, element := range value.Elements() {
+				elementStr, err := Represent(ctx, element)
+				if err != nil {
+					return nil, err
+				}
+
+				str += elementStr.(String)
+				if i < len(value.elements.entries)-1 {
+					str += ", "
+				}
+			}
+
+			if value.frozen {
+				return String(fmt.Sprintf("%s({%s})", FrozenSetClass.Name, str)), nil
+			}
+
+			return String(fmt.Sprintf("{%s}", str)), nil
+		},
+	)
+}
+
+func (value *Set) toBool(_ Context) (Object, error) {
+	return gb2bo(len(value.elements.entries) != 0), nil
+}
+
+func (value *Set) hash(ctx Context) (Object, error) {
+	if !value.frozen {
+		return nil, NewTypeErrorf("нехешований тип: '%s'", value.Class().Name)
+	}
+
+	// The order of elements must not affect the hash, so hashes
+	// of elements are mixed and summed up.
+	result := uint64(len(value.elements.entries))
+	for _, element := range value.Elements() {
+		elementHash, err := Hash(ctx, element)
+		if err != nil {
+			return nil, err
+		}
+
+		h := uint64(elementHash.(Int))
+		result += (h ^ (h << 16) ^ 89869747) * 3644798167
+	}
+
+	return Int(result), nil
+}
+
+func (value *Set) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
+func (value *Set) contains(ctx Context, item Object) (Object, error) {
+	has, err := value.has(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return gb2bo(has), nil
+}
+
+func (value *Set) iterate(_ Context) (Object, error) {
+	return &sliceIterator{elements: value.Elements(), index: 0}, nil
+}
+
+func (value *Set) bitwiseOr(ctx Context, other Object) (Object, error) {
+	return value.combine(
+		ctx, other, "|", func(inValue, inOther bool) bool {
+			return inValue || inOther
+		},
+	)
+}
+
+func (value *Set) bitwiseAnd(ctx Context, other Object) (Object, error) {
+	return value.combine(
+		ctx, other, "&", func(inValue, inOther bool) bool {
+			return inValue && inOther
+		},
+	)
+}
+
+func (value *Set) bitwiseXor(ctx Context, other Object) (Object, error) {
+	return value.combine(
+		ctx, other, "^", func(inValue, inOther bool) bool {
+			return inValue != inOther
+		},
+	)
+}
+
+func (value *Set) sub(ctx Context, other Object) (Object, error) {
+	return value.combine(
+		ctx, other, "-", func(inValue, inOther bool) bool {
+			return inValue && !inOther
+		},
+	)
+}
+
+// combine creates a set of the same kind as the left operand, which
+// contains elements of both sets that satisfy the predicate.
+func (value *Set) combine(
+	ctx Context,
+	other Object,
+	operator string,
+	predicate func(inValue, inOther bool) bool,
+) (Object, error) {
+	otherSet, ok := other.(*Set)
+	if !ok {
+		return nil, OperatorNotSupportedErrorNew(operator, value.Class().Name, other.Class().Name)
+	}
+
+	result := newSetLike(value)
+	for _, element := range value.Elements() {
+		inOther, err := otherSet.has(ctx, element)
+		if err != nil {
+			return nil, err
+		}
+
+		if predicate(true, inOther) {
+			if err = result.Add(ctx, element); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, element := range otherSet.Elements() {
+		inValue, err := value.has(ctx, element)
+		if err != nil {
+			return nil, err
+		}
+
+		if !inValue && predicate(false, true) {
+			if err = result.Add(ctx, element); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// isSubsetOf checks whether all elements of the set are in the other set.
+func (value *Set) isSubsetOf(ctx Context, other *Set) (bool, error) {
+	if len(value.elements.entries) > len(other.elements.entries) {
+		return false, nil
+	}
+
+	for _, element := range value.Elements() {
+		has, err := other.has(ctx, element)
+		if err != nil || !has {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (value *Set) equals(ctx Context, other Object) (Object, error) {
+	if s, ok := other.(*Set); ok {
+		if len(value.elements.entries) != len(s.elements.entries) {
+			return False, nil
+		}
+
+		result, err := value.isSubsetOf(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+
+		return gb2bo(result), nil
+	}
+
+	return False, nil
+}
+
+func (value *Set) notEquals(ctx Context, other Object) (Object, error) {
+	result, err := value.equals(ctx, other)
+	if err != nil {
+		return nil, err
+	}
+
+	return !result.(Bool), nil
+}
+
+func (value *Set) lessOrEquals(ctx Context, other Object) (Object, error) {
+	return compareSets(ctx, value, other, "<=", false)
+}
+
+func (value *Set) less(ctx Context, other Object) (Object, error) {
+	return compareSets(ctx, value, other, "<", true)
+}
+
+func (value *Set) greaterOrEquals(ctx Context, other Object) (Object, error) {
+	return compareSets(ctx, other, value, ">=", false)
+}
+
+func (value *Set) greater(ctx Context, other Object) (Object, error) {
+	return compareSets(ctx, other, value, ">", true)
+}
+
+// compareSets checks whether 'subset' is a subset of 'superset', and if
+// 'proper' is true, whether 'superset' has more elements.
+func compareSets(ctx Context, subset, superset Object, operator string, proper bool) (Object, error) {
+	a, aOk := subset.(*Set)
+	b, bOk := superset.(*Set)
+	if !aOk || !bOk {
+		return nil, OperatorNotSupportedErrorNew(operator, subset.Class().Name, superset.Class().Name)
+	}
+
+	if proper && len(a.elements.entries) >= len(b.elements.entries) {
+		return False, nil
+	}
+
+	result, err := a.isSubsetOf(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return gb2bo(result), nil
+}
+
+func (value *Set) copy(ctx Context) (Object, error) {
+	if value.frozen {
+		return value, nil
+	}
+
+	return fillSet(ctx, SetClass, NewSet(), Tuple{value})
+}
+
+func (value *Set) deepCopy(ctx Context, memo map[Object]Object) (Object, error) {
+	set := newSetLike(value)
+	memo[value] = set
+	for _, element := range value.Elements() {
+		elementCopy, err := deepCopy(ctx, element, memo)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = set.Add(ctx, elementCopy); err != nil {
+			return nil, err
+		}
+	}
+
+	return set, nil
+}
@@ -0,0 +1,191 @@
+package types
+
+type setMethodFunc func(ctx Context, self *Set, args Tuple) (Object, error)
+
+// newSetMethod creates a method of set classes, the first parameter
+// of which is the set itself.
+func newSetMethod(
+	pkg *Package,
+	name string,
+	selfClasses []*Class,
+	parameters []MethodParameter,
+	returnType *Class,
+	f setMethodFunc,
+) *Method {
+	return MethodNew(
+		name,
+		pkg,
+		append(
+			[]MethodParameter{
+				{
+					Class:      nil,
+					Classes:    selfClasses,
+					Name:       "я",
+					IsNullable: false,
+					IsVariadic: false,
+				},
+			},
+			parameters...,
+		),
+		[]MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: returnType == NilClass,
+			},
+		},
+		func(ctx Context, args Tuple, _ StringDict) (Object, error) {
+			return f(ctx, args[0].(*Set), args[1:])
+		},
+	)
+}
+
+func setParameter(name string) MethodParameter {
+	return MethodParameter{
+		Class:      nil,
+		Classes:    []*Class{SetClass, FrozenSetClass},
+		Name:       name,
+		IsNullable: false,
+		IsVariadic: false,
+	}
+}
+
+func makeCommonSetMethods(pkg *Package, selfClass *Class) []*Method {
+	selfClasses := []*Class{selfClass}
+	return []*Method{
+		newSetMethod(
+			pkg, "копія", selfClasses, nil, selfClass,
+			func(ctx Context, self *Set, _ Tuple) (Object, error) {
+				return fillSet(ctx, selfClass, newSetLike(self), Tuple{self})
+			},
+		),
+		newSetMethod(
+			pkg, "це_підмножина", selfClasses, []MethodParameter{setParameter("інша")}, BoolClass,
+			func(ctx Context, self *Set, args Tuple) (Object, error) {
+				result, err := self.isSubsetOf(ctx, args[0].(*Set))
+				if err != nil {
+					return nil, err
+				}
+
+				return gb2bo(result), nil
+			},
+		),
+		newSetMethod(
+			pkg, "це_надмножина", selfClasses, []MethodParameter{setParameter("інша")}, BoolClass,
+			func(ctx Context, self *Set, args Tuple) (Object, error) {
+				result, err := args[0].(*Set).isSubsetOf(ctx, self)
+				if err != nil {
+					return nil, err
+				}
+
+				return gb2bo(result), nil
+			},
+		),
+		newSetMethod(
+			pkg, "не_перетинається", selfClasses, []MethodParameter{setParameter("інша")}, BoolClass,
+			func(ctx Context, self *Set, args Tuple) (Object, error) {
+				for _, element := range self.Elements() {
+					has, err := args[0].(*Set).has(ctx, element)
+					if err != nil {
+						return nil, err
+					}
+
+					if has {
+						return False, nil
+					}
+				}
+
+				return True, nil
+			},
+		),
+	}
+}
+
+func MakeSetClassMethods(pkg *Package) StringDict {
+	selfClasses := []*Class{SetClass}
+	methods := append(
+		makeCommonSetMethods(pkg, SetClass),
+		newSetMethod(
+			pkg, "додати", selfClasses, []MethodParameter{objectParameter("елемент")}, NilClass,
+			func(ctx Context, self *Set, args Tuple) (Object, error) {
+				return Nil, self.Add(ctx, args[0])
+			},
+		),
+		newSetMethod(
+			pkg, "видалити", selfClasses, []MethodParameter{objectParameter("елемент")}, NilClass,
+			func(ctx Context, self *Set, args Tuple) (Object, error) {
+				has, err := self.has(ctx, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				if !has {
+					elementStr, err := Represent(ctx, args[0])
+					if err != nil {
+						return nil, err
+					}
+
+					return nil, NewKeyErrorf("множина не містить елемента %s", elementStr)
+				}
+
+				if _, err = self.elements.DeleteItem(ctx, args[0]); err != nil {
+					return nil, err
+				}
+
+				return Nil, nil
+			},
+		),
+		newSetMethod(
+			pkg, "відкинути", selfClasses, []MethodParameter{objectParameter("елемент")}, NilClass,
+			func(ctx Context, self *Set, args Tuple) (Object, error) {
+				has, err := self.has(ctx, args[0])
+				if err != nil || !has {
+					return Nil, err
+				}
+
+				if _, err = self.elements.DeleteItem(ctx, args[0]); err != nil {
+					return nil, err
+				}
+
+				return Nil, nil
+			},
+		),
+		newSetMethod(
+			pkg, "вилучити", selfClasses, nil, ObjectClass,
+			func(ctx Context, self *Set, _ Tuple) (Object, error) {
+				elements := self.Elements()
+				if len(elements) == 0 {
+					return nil, NewKeyErrorf("неможливо вилучити елемент з порожньої множини")
+				}
+
+				if _, err := self.elements.DeleteItem(ctx, elements[0]); err != nil {
+					return nil, err
+				}
+
+				return elements[0], nil
+			},
+		),
+		newSetMethod(
+			pkg, "очистити", selfClasses, nil, NilClass,
+			func(_ Context, self *Set, _ Tuple) (Object, error) {
+				self.elements = NewDictionary()
+				return Nil, nil
+			},
+		),
+	)
+
+	dict := StringDict{}
+	for _, method := range methods {
+		dict[method.Name] = method
+	}
+
+	return dict
+}
+
+func MakeFrozenSetClassMethods(pkg *Package) StringDict {
+	dict := StringDict{}
+	for _, method := range makeCommonSetMethods(pkg, FrozenSetClass) {
+		dict[method.Name] = method
+	}
+
+	return dict
+}
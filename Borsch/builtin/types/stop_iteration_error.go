@@ -0,0 +1,40 @@
+package types
+
+import "fmt"
+
+var StopIterationErrorClass *Class
+
+// StopIterationError is raised by the '__наступний__' operator
+// to signal that the iterator has no more elements.
+type StopIterationError struct {
+	message string
+}
+
+func (value *StopIterationError) Error() string {
+	return fmt.Sprintf("%s: %s", value.Class().Name, value.message)
+}
+
+func (value *StopIterationError) Class() *Class {
+	return StopIterationErrorClass
+}
+
+func StopIterationErrorNew(ctx Context, cls *Class, args Tuple) (Object, error) {
+	message, err := errorMessageFromArgs(ctx, cls, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StopIterationError{message: message}, nil
+}
+
+func NewStopIterationError() *StopIterationError {
+	return &StopIterationError{message: ""}
+}
+
+func (value *StopIterationError) represent(ctx Context) (Object, error) {
+	return value.string(ctx)
+}
+
+func (value *StopIterationError) string(_ Context) (Object, error) {
+	return String(value.message), nil
+}
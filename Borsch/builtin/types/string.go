@@ -3,7 +3,10 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"hash/fnv"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 )
 
 var StringClass = ObjectClass.ClassNew("рядок", map[string]Object{}, true, StringNew, nil)
@@ -23,10 +26,68 @@ func (value String) string(Context) (Object, error) {
 	return value, nil
 }
 
+func (value String) hash(Context) (Object, error) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(value))
+	return Int(h.Sum64()), nil
+}
+
 func (value String) toBool(Context) (Object, error) {
 	return Bool(value != ""), nil
 }
 
+func (value String) contains(_ Context, item Object) (Object, error) {
+	if s, ok := item.(String); ok {
+		return gb2bo(strings.Contains(string(value), string(s))), nil
+	}
+
+	return nil, NewTypeErrorf(
+		"лівий операнд оператора 'в' для рядка має бути типу 'рядок', отримано '%s'",
+		item.Class().Name,
+	)
+}
+
+func (value String) Length(_ Context) (Int, error) {
+	return Int(utf8.RuneCountInString(string(value))), nil
+}
+
+func (value String) GetElement(_ Context, index Int) (Object, error) {
+	offsets := getRuneOffsets(string(value))
+	if err := checkIndex(index, Int(offsets.length), "рядка"); err != nil {
+		return nil, err
+	}
+
+	start, end := offsets.bounds(int(index), int(index)+1)
+	return value[start:end], nil
+}
+
+func (value String) SetElement(_ Context, _ Int, _ Object) (Object, error) {
+	return nil, NewTypeErrorf("рядки незмінні, тому неможливо змінити їхні символи")
+}
+
+func (value String) Slice(_ Context, leftBound, rightBound Int) (Object, error) {
+	offsets := getRuneOffsets(string(value))
+	length := Int(offsets.length)
+	if leftBound < 0 {
+		leftBound = 0
+	}
+
+	if rightBound > length {
+		rightBound = length
+	}
+
+	if leftBound >= rightBound {
+		return String(""), nil
+	}
+
+	start, end := offsets.bounds(int(leftBound), int(rightBound))
+	return value[start:end], nil
+}
+
+func (value String) getAttribute(_ Context, name string) (Object, error) {
+	return getNativeAttribute(value, name)
+}
+
 func (value String) add(_ Context, other Object) (Object, error) {
 	if s, ok := other.(String); ok {
 		return value + s, nil
@@ -101,16 +162,12 @@ func (value String) notEquals(_ Context, other Object) (Object, error) {
 		return goBoolToBoolObject(value != v), nil
 	}
 
-	if _, ok := other.(Bool); ok {
-		return True, nil
-	}
-
-	return False, nil
+	return True, nil
 }
 
 func (value String) less(_ Context, other Object) (Object, error) {
 	if v, ok := other.(String); ok {
-		return goBoolToBoolObject(value < v), nil
+		return goBoolToBoolObject(Collate(string(value), string(v), false) < 0), nil
 	}
 
 	return False, nil
@@ -118,7 +175,7 @@ func (value String) less(_ Context, other Object) (Object, error) {
 
 func (value String) lessOrEquals(_ Context, other Object) (Object, error) {
 	if v, ok := other.(String); ok {
-		return goBoolToBoolObject(value <= v), nil
+		return goBoolToBoolObject(Collate(string(value), string(v), false) <= 0), nil
 	}
 
 	return False, nil
@@ -126,7 +183,7 @@ func (value String) lessOrEquals(_ Context, other Object) (Object, error) {
 
 func (value String) greater(_ Context, other Object) (Object, error) {
 	if v, ok := other.(String); ok {
-		return goBoolToBoolObject(value > v), nil
+		return goBoolToBoolObject(Collate(string(value), string(v), false) > 0), nil
 	}
 
 	return False, nil
@@ -134,7 +191,7 @@ func (value String) greater(_ Context, other Object) (Object, error) {
 
 func (value String) greaterOrEquals(_ Context, other Object) (Object, error) {
 	if v, ok := other.(String); ok {
-		return goBoolToBoolObject(value >= v), nil
+		return goBoolToBoolObject(Collate(string(value), string(v), false) >= 0), nil
 	}
 
 	return False, nil
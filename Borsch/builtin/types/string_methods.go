@@ -0,0 +1,376 @@
+package types
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type stringMethodFunc func(ctx Context, self String, args Tuple) (Object, error)
+
+// newStringMethod creates a method of 'рядок' class, the first
+// parameter of which is the string itself.
+func newStringMethod(
+	pkg *Package,
+	name string,
+	parameters []MethodParameter,
+	returnType *Class,
+	f stringMethodFunc,
+) *Method {
+	return MethodNew(
+		name,
+		pkg,
+		append(
+			[]MethodParameter{
+				{
+					Class:      StringClass,
+					Classes:    nil,
+					Name:       "я",
+					IsNullable: false,
+					IsVariadic: false,
+				},
+			},
+			parameters...,
+		),
+		[]MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: false,
+			},
+		},
+		func(ctx Context, args Tuple, _ StringDict) (Object, error) {
+			return f(ctx, args[0].(String), args[1:])
+		},
+	)
+}
+
+func stringParameter(name string) MethodParameter {
+	return MethodParameter{
+		Class:      StringClass,
+		Classes:    nil,
+		Name:       name,
+		IsNullable: false,
+		IsVariadic: false,
+	}
+}
+
+func intParameter(name string) MethodParameter {
+	return MethodParameter{
+		Class:      IntClass,
+		Classes:    nil,
+		Name:       name,
+		IsNullable: false,
+		IsVariadic: false,
+	}
+}
+
+// runeIndex converts the byte offset in the string to the index of
+// the code point, -1 stays unchanged.
+func runeIndex(s string, offset int) Int {
+	if offset < 0 {
+		return Int(offset)
+	}
+
+	return Int(utf8.RuneCountInString(s[:offset]))
+}
+
+// isEveryRune checks whether the string is not empty and all of
+// its code points satisfy the predicate.
+func isEveryRune(s String, predicate func(rune) bool) Object {
+	if s == "" {
+		return False
+	}
+
+	for _, r := range s {
+		if !predicate(r) {
+			return False
+		}
+	}
+
+	return True
+}
+
+// isCased checks whether the string has at least one letter which
+// satisfies the case predicate and has no letters of the opposite case.
+func isCased(s String, predicate, opposite func(rune) bool) Object {
+	return gb2bo(strings.IndexFunc(string(s), opposite) == -1 && strings.IndexFunc(string(s), predicate) != -1)
+}
+
+// padString adds the fill character to the string until the string
+// has the given width in code points.
+func padString(self String, args Tuple, left bool) (Object, error) {
+	width, err := smallInt(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	fill := string(args[1].(String))
+	if utf8.RuneCountInString(fill) != 1 {
+		return nil, NewValueErrorf("символ заповнення має бути рядком довжиною 1, отримано \"%s\"", fill)
+	}
+
+	length := Int(utf8.RuneCountInString(string(self)))
+	if width <= length {
+		return self, nil
+	}
+
+	padding := String(strings.Repeat(fill, int(width-length)))
+	if left {
+		return padding + self, nil
+	}
+
+	return self + padding, nil
+}
+
+func MakeStringClassMethods(pkg *Package) StringDict {
+	methods := []*Method{
+		newStringMethod(
+			pkg, "розділити", []MethodParameter{stringParameter("роздільник")}, ListClass,
+			func(_ Context, self String, args Tuple) (Object, error) {
+				separator := string(args[0].(String))
+				if separator == "" {
+					return nil, NewValueErrorf("роздільник не може бути порожнім рядком")
+				}
+
+				list := NewList()
+				for _, part := range strings.Split(string(self), separator) {
+					list.Values = append(list.Values, String(part))
+				}
+
+				return list, nil
+			},
+		),
+		newStringMethod(
+			pkg, "слова", nil, ListClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				list := NewList()
+				for _, word := range strings.Fields(string(self)) {
+					list.Values = append(list.Values, String(word))
+				}
+
+				return list, nil
+			},
+		),
+		newStringMethod(
+			pkg, "рядки", nil, ListClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				list := NewList()
+				if self == "" {
+					return list, nil
+				}
+
+				lines := strings.Split(strings.ReplaceAll(string(self), "\r\n", "\n"), "\n")
+				if lines[len(lines)-1] == "" {
+					lines = lines[:len(lines)-1]
+				}
+
+				for _, line := range lines {
+					list.Values = append(list.Values, String(line))
+				}
+
+				return list, nil
+			},
+		),
+		newStringMethod(
+			pkg, "з_єднати", []MethodParameter{
+				{
+					Class:      ObjectClass,
+					Classes:    nil,
+					Name:       "елементи",
+					IsNullable: false,
+					IsVariadic: false,
+				},
+			}, StringClass,
+			func(ctx Context, self String, args Tuple) (Object, error) {
+				var parts []string
+				err := IterateOver(
+					ctx, args[0], func(element Object) (bool, error) {
+						part, ok := element.(String)
+						if !ok {
+							return false, NewTypeErrorf(
+								"елемент %d має бути типу 'рядок', отримано '%s'",
+								len(parts),
+								element.Class().Name,
+							)
+						}
+
+						parts = append(parts, string(part))
+						return false, nil
+					},
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				return String(strings.Join(parts, string(self))), nil
+			},
+		),
+		newStringMethod(
+			pkg, "замінити", []MethodParameter{stringParameter("старе"), stringParameter("нове")}, StringClass,
+			func(_ Context, self String, args Tuple) (Object, error) {
+				return String(strings.ReplaceAll(string(self), string(args[0].(String)), string(args[1].(String)))), nil
+			},
+		),
+		newStringMethod(
+			pkg, "знайти", []MethodParameter{stringParameter("підрядок")}, IntClass,
+			func(_ Context, self String, args Tuple) (Object, error) {
+				return runeIndex(string(self), strings.Index(string(self), string(args[0].(String)))), nil
+			},
+		),
+		newStringMethod(
+			pkg, "знайти_останній", []MethodParameter{stringParameter("підрядок")}, IntClass,
+			func(_ Context, self String, args Tuple) (Object, error) {
+				return runeIndex(string(self), strings.LastIndex(string(self), string(args[0].(String)))), nil
+			},
+		),
+		newStringMethod(
+			pkg, "кількість", []MethodParameter{stringParameter("підрядок")}, IntClass,
+			func(_ Context, self String, args Tuple) (Object, error) {
+				return Int(strings.Count(string(self), string(args[0].(String)))), nil
+			},
+		),
+		newStringMethod(
+			pkg, "починається_з", []MethodParameter{stringParameter("префікс")}, BoolClass,
+			func(_ Context, self String, args Tuple) (Object, error) {
+				return gb2bo(strings.HasPrefix(string(self), string(args[0].(String)))), nil
+			},
+		),
+		newStringMethod(
+			pkg, "закінчується_на", []MethodParameter{stringParameter("суфікс")}, BoolClass,
+			func(_ Context, self String, args Tuple) (Object, error) {
+				return gb2bo(strings.HasSuffix(string(self), string(args[0].(String)))), nil
+			},
+		),
+		newStringMethod(
+			pkg, "обрізати", nil, StringClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				return String(strings.TrimSpace(string(self))), nil
+			},
+		),
+		newStringMethod(
+			pkg, "обрізати_зліва", nil, StringClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				return String(strings.TrimLeftFunc(string(self), unicode.IsSpace)), nil
+			},
+		),
+		newStringMethod(
+			pkg, "обрізати_справа", nil, StringClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				return String(strings.TrimRightFunc(string(self), unicode.IsSpace)), nil
+			},
+		),
+		newStringMethod(
+			pkg, "обрізати_символи", []MethodParameter{stringParameter("символи")}, StringClass,
+			func(_ Context, self String, args Tuple) (Object, error) {
+				return String(strings.Trim(string(self), string(args[0].(String)))), nil
+			},
+		),
+		newStringMethod(
+			pkg, "повторити", []MethodParameter{intParameter("кількість")}, StringClass,
+			func(ctx Context, self String, args Tuple) (Object, error) {
+				return self.mul(ctx, args[0])
+			},
+		),
+		newStringMethod(
+			pkg, "доповнити_зліва", []MethodParameter{intParameter("ширина"), stringParameter("символ")}, StringClass,
+			func(_ Context, self String, args Tuple) (Object, error) {
+				return padString(self, args, true)
+			},
+		),
+		newStringMethod(
+			pkg, "доповнити_справа", []MethodParameter{intParameter("ширина"), stringParameter("символ")}, StringClass,
+			func(_ Context, self String, args Tuple) (Object, error) {
+				return padString(self, args, false)
+			},
+		),
+		newStringMethod(
+			pkg, "великі", nil, StringClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				return String(strings.ToUpper(string(self))), nil
+			},
+		),
+		newStringMethod(
+			pkg, "малі", nil, StringClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				return String(strings.ToLower(string(self))), nil
+			},
+		),
+		newStringMethod(
+			pkg, "з_великої", nil, StringClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				return String(capitalize(string(self))), nil
+			},
+		),
+		newStringMethod(
+			pkg, "заголовок", nil, StringClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				return String(toTitle(string(self))), nil
+			},
+		),
+		newStringMethod(
+			pkg, "порівняти", []MethodParameter{stringParameter("інший")}, IntClass,
+			func(_ Context, self String, args Tuple) (Object, error) {
+				return Int(Collate(string(self), string(args[0].(String)), false)), nil
+			},
+		),
+		newStringMethod(
+			pkg, "порівняти_без_регістру", []MethodParameter{stringParameter("інший")}, IntClass,
+			func(_ Context, self String, args Tuple) (Object, error) {
+				return Int(Collate(string(self), string(args[0].(String)), true)), nil
+			},
+		),
+		newStringMethod(
+			pkg, "дорівнює_без_регістру", []MethodParameter{stringParameter("інший")}, BoolClass,
+			func(_ Context, self String, args Tuple) (Object, error) {
+				return gb2bo(Collate(string(self), string(args[0].(String)), true) == 0), nil
+			},
+		),
+		newStringMethod(
+			pkg, "це_літери", nil, BoolClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				return isEveryRune(self, unicode.IsLetter), nil
+			},
+		),
+		newStringMethod(
+			pkg, "це_цифри", nil, BoolClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				return isEveryRune(self, unicode.IsDigit), nil
+			},
+		),
+		newStringMethod(
+			pkg, "це_літери_або_цифри", nil, BoolClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				return isEveryRune(
+					self, func(r rune) bool {
+						return unicode.IsLetter(r) || unicode.IsDigit(r)
+					},
+				), nil
+			},
+		),
+		newStringMethod(
+			pkg, "це_пробіли", nil, BoolClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				return isEveryRune(self, unicode.IsSpace), nil
+			},
+		),
+		newStringMethod(
+			pkg, "це_великі", nil, BoolClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				return isCased(self, unicode.IsUpper, unicode.IsLower), nil
+			},
+		),
+		newStringMethod(
+			pkg, "це_малі", nil, BoolClass,
+			func(_ Context, self String, _ Tuple) (Object, error) {
+				return isCased(self, unicode.IsLower, unicode.IsUpper), nil
+			},
+		),
+	}
+
+	dict := StringDict{}
+	for _, method := range methods {
+		dict[method.Name] = method
+	}
+
+	return dict
+}
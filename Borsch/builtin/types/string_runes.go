@@ -0,0 +1,93 @@
+package types
+
+import (
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	// runeOffsetsCacheSize is a number of recently indexed strings
+	// which offsets of code points are kept for.
+	runeOffsetsCacheSize = 8
+
+	// minCachedRuneOffsetsLength is the length in bytes of the shortest
+	// string which offsets are cached. Shorter strings are decoded
+	// faster than they are looked up in the cache.
+	minCachedRuneOffsetsLength = 256
+)
+
+// runeOffsets maps indices of code points of the string
+// to byte offsets.
+type runeOffsets struct {
+	str    string
+	length int
+
+	// offsets holds the byte offset of each code point followed by
+	// the length of the string in bytes. It is nil for ASCII strings,
+	// where indices of code points are equal to byte offsets.
+	offsets []int
+}
+
+// bounds returns byte offsets of the code points range [start, end).
+func (value runeOffsets) bounds(start, end int) (int, int) {
+	if value.offsets == nil {
+		return start, end
+	}
+
+	return value.offsets[start], value.offsets[end]
+}
+
+// runeOffsetsCache keeps offsets of recently indexed long strings, so
+// indexing of the same string in a loop doesn't decode it from the
+// beginning each time. Comparison of the string which shares
+// the memory with the cached one doesn't depend on its length.
+var runeOffsetsCache struct {
+	sync.Mutex
+
+	entries [runeOffsetsCacheSize]*runeOffsets
+	next    int
+}
+
+// getRuneOffsets returns offsets of code points of the string. Only
+// offsets of long strings are cached, short strings don't need the
+// cache and its lock.
+func getRuneOffsets(s string) runeOffsets {
+	if len(s) < minCachedRuneOffsetsLength {
+		return newRuneOffsets(s)
+	}
+
+	runeOffsetsCache.Lock()
+	defer runeOffsetsCache.Unlock()
+
+	for _, entry := range runeOffsetsCache.entries {
+		if entry != nil && entry.str == s {
+			return *entry
+		}
+	}
+
+	entry := newRuneOffsets(s)
+	runeOffsetsCache.entries[runeOffsetsCache.next] = &entry
+	runeOffsetsCache.next = (runeOffsetsCache.next + 1) % runeOffsetsCacheSize
+	return entry
+}
+
+func newRuneOffsets(s string) runeOffsets {
+	isASCII := true
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			isASCII = false
+			break
+		}
+	}
+
+	if isASCII {
+		return runeOffsets{str: s, length: len(s), offsets: nil}
+	}
+
+	offsets := make([]int, 0, utf8.RuneCountInString(s)+1)
+	for i := range s {
+		offsets = append(offsets, i)
+	}
+
+	return runeOffsets{str: s, length: len(offsets), offsets: append(offsets, len(s))}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"strings"
+	"testing"
+)
+
+func benchmarkStringGetElement(b *testing.B, s String) {
+	length, err := s.Length(nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := s.GetElement(nil, Int(i)%length); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkString_GetElementASCII(b *testing.B) {
+	benchmarkStringGetElement(b, String(strings.Repeat("borsch", 1000)))
+}
+
+func BenchmarkString_GetElementShort(b *testing.B) {
+	benchmarkStringGetElement(b, "борщ з пампушками")
+}
+
+func BenchmarkString_GetElementLong(b *testing.B) {
+	benchmarkStringGetElement(b, String(strings.Repeat("борщ", 1000)))
+}
+
+// BenchmarkString_GetElementManyLong indexes more long strings than the
+// cache of offsets holds.
+func BenchmarkString_GetElementManyLong(b *testing.B) {
+	values := make([]String, runeOffsetsCacheSize*2)
+	for i := range values {
+		values[i] = String(strings.Repeat("борщ", 100+i))
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := values[i%len(values)].GetElement(nil, 42); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkString_GetElementParallel(b *testing.B) {
+	s := String(strings.Repeat("борщ", 1000))
+	b.ReportAllocs()
+	b.RunParallel(
+		func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				if _, err := s.GetElement(nil, Int(i%4000)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		},
+	)
+}
+
+func TestString_GetElement(t *testing.T) {
+	for _, s := range []String{"borsch", "борщ з пампушками", String(strings.Repeat("борщ", 100))} {
+		expected := []rune(string(s))
+		for i, r := range expected {
+			actual, err := s.GetElement(nil, Int(i))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if actual != String(r) {
+				t.Errorf("Assertion failed:\nActual:\n%s\n\nExpected:\n%s", actual, string(r))
+			}
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Names of months and weekdays in Ukrainian. Weekdays start from
+// Monday. The genitive form of the month is used in dates, i.e.
+// "19 жовтня 2026".
+var (
+	MonthNames = []string{
+		"січень", "лютий", "березень", "квітень", "травень", "червень",
+		"липень", "серпень", "вересень", "жовтень", "листопад", "грудень",
+	}
+	MonthGenitiveNames = []string{
+		"січня", "лютого", "березня", "квітня", "травня", "червня",
+		"липня", "серпня", "вересня", "жовтня", "листопада", "грудня",
+	}
+	MonthShortNames = []string{
+		"січ", "лют", "бер", "кві", "тра", "чер", "лип", "сер", "вер", "жов", "лис", "гру",
+	}
+	WeekdayNames = []string{
+		"понеділок", "вівторок", "середа", "четвер", "п'ятниця", "субота", "неділя",
+	}
+	WeekdayShortNames = []string{
+		"пн", "вт", "ср", "чт", "пт", "сб", "нд",
+	}
+)
+
+// weekdayIndex returns the index of the weekday starting from Monday.
+func weekdayIndex(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
+
+func unknownDirective(directive string) error {
+	return NewValueErrorf("невідома директива '%%%s' у шаблоні дати", directive)
+}
+
+// nextDirective returns the directive after '%' at the start of the
+// template and the length of the directive in bytes. "O" is the prefix
+// of the nominative month name "%OB".
+func nextDirective(template string) (string, int) {
+	if strings.HasPrefix(template, "OB") {
+		return "OB", 2
+	}
+
+	r, size := utf8.DecodeRuneInString(template)
+	return string(r), size
+}
+
+// FormatTime formats the time using the template with directives
+// similar to strftime:
+//
+//	%Y - рік, %y - рік з двох цифр, %m - місяць, %d - день,
+//	%H - година, %M - хвилина, %S - секунда, %f - мікросекунди,
+//	%j - день року, %u - день тижня від 1 (понеділок) до 7,
+//	%B - місяць у родовому відмінку ("жовтня"),
+//	%OB - місяць у називному відмінку ("жовтень"), %b - скорочений місяць,
+//	%A - день тижня, %a - скорочений день тижня,
+//	%z - зміщення часового поясу ("+0300"), %Z - скорочена назва поясу,
+//	%% - символ '%'.
+func FormatTime(t time.Time, template string) (string, error) {
+	var builder strings.Builder
+	for len(template) > 0 {
+		i := strings.IndexByte(template, '%')
+		if i < 0 {
+			builder.WriteString(template)
+			break
+		}
+
+		builder.WriteString(template[:i])
+		template = template[i+1:]
+		if len(template) == 0 {
+			return "", NewValueErrorf("шаблон дати закінчується символом '%%'")
+		}
+
+		directive, size := nextDirective(template)
+		template = template[size:]
+		switch directive {
+		case "Y":
+			builder.WriteString(fmt.Sprintf("%04d", t.Year()))
+		case "y":
+			builder.WriteString(fmt.Sprintf("%02d", t.Year()%100))
+		case "m":
+			builder.WriteString(fmt.Sprintf("%02d", int(t.Month())))
+		case "d":
+			builder.WriteString(fmt.Sprintf("%02d", t.Day()))
+		case "H":
+			builder.WriteString(fmt.Sprintf("%02d", t.Hour()))
+		case "M":
+			builder.WriteString(fmt.Sprintf("%02d", t.Minute()))
+		case "S":
+			builder.WriteString(fmt.Sprintf("%02d", t.Second()))
+		case "f":
+			builder.WriteString(fmt.Sprintf("%06d", t.Nanosecond()/1000))
+		case "j":
+			builder.WriteString(fmt.Sprintf("%03d", t.YearDay()))
+		case "u":
+			builder.WriteString(fmt.Sprintf("%d", weekdayIndex(t)+1))
+		case "B":
+			builder.WriteString(MonthGenitiveNames[t.Month()-1])
+		case "OB":
+			builder.WriteString(MonthNames[t.Month()-1])
+		case "b":
+			builder.WriteString(MonthShortNames[t.Month()-1])
+		case "A":
+			builder.WriteString(WeekdayNames[weekdayIndex(t)])
+		case "a":
+			builder.WriteString(WeekdayShortNames[weekdayIndex(t)])
+		case "z":
+			builder.WriteString(t.Format("-0700"))
+		case "Z":
+			builder.WriteString(t.Format("MST"))
+		case "%":
+			builder.WriteByte('%')
+		default:
+			return "", unknownDirective(directive)
+		}
+	}
+
+	return builder.String(), nil
+}
+
+// timeParser reads values of the directives from the text.
+type timeParser struct {
+	text string
+}
+
+// number reads a number of at most maxDigits digits.
+func (p *timeParser) number(maxDigits int) (int, bool) {
+	value, digits := 0, 0
+	for digits < maxDigits && digits < len(p.text) && p.text[digits] >= '0' && p.text[digits] <= '9' {
+		value = value*10 + int(p.text[digits]-'0')
+		digits++
+	}
+
+	p.text = p.text[digits:]
+	return value, digits > 0
+}
+
+// name reads one of the names ignoring the case, the longest matching
+// name is preferred, and returns its index.
+func (p *timeParser) name(names ...[]string) (int, bool) {
+	index, length := -1, 0
+	for _, list := range names {
+		for i, name := range list {
+			if len(name) > length && len(p.text) >= len(name) && strings.EqualFold(p.text[:len(name)], name) {
+				index, length = i, len(name)
+			}
+		}
+	}
+
+	p.text = p.text[length:]
+	return index, index >= 0
+}
+
+// offset reads the offset of the time zone as "+0300", "+03:00" or "Z".
+func (p *timeParser) offset() (int, bool) {
+	if strings.HasPrefix(p.text, "Z") {
+		p.text = p.text[1:]
+		return 0, true
+	}
+
+	if len(p.text) == 0 || p.text[0] != '+' && p.text[0] != '-' {
+		return 0, false
+	}
+
+	sign := 1
+	if p.text[0] == '-' {
+		sign = -1
+	}
+
+	p.text = p.text[1:]
+	hours, ok := p.number(2)
+	if !ok {
+		return 0, false
+	}
+
+	if strings.HasPrefix(p.text, ":") {
+		p.text = p.text[1:]
+	}
+
+	minutes, ok := p.number(2)
+	if !ok {
+		return 0, false
+	}
+
+	return sign * (hours*3600 + minutes*60), true
+}
+
+// ParseTime parses the time using the template of FormatTime. Names
+// of months are accepted in any form and case. Missing parts of the
+// date default to 1 January 1900, the time is in the location unless
+// the template contains the offset '%z'. The name of the time zone
+// '%Z' can not be parsed.
+func ParseTime(text, template string, location *time.Location) (time.Time, error) {
+	mismatch := func() (time.Time, error) {
+		return time.Time{}, NewValueErrorf("рядок '%s' не відповідає шаблону дати '%s'", text, template)
+	}
+
+	year, month, day, hour, minute, second, nanosecond, yearDay := 1900, 1, 1, 0, 0, 0, 0, 0
+	p := &timeParser{text: text}
+	for rest := template; len(rest) > 0; {
+		if rest[0] != '%' {
+			r, size := utf8.DecodeRuneInString(rest)
+			if unicode.IsSpace(r) {
+				p.text = strings.TrimLeftFunc(p.text, unicode.IsSpace)
+				rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+				continue
+			}
+
+			if !strings.HasPrefix(p.text, rest[:size]) {
+				return mismatch()
+			}
+
+			p.text, rest = p.text[size:], rest[size:]
+			continue
+		}
+
+		if len(rest) == 1 {
+			return time.Time{}, NewValueErrorf("шаблон дати закінчується символом '%%'")
+		}
+
+		directive, size := nextDirective(rest[1:])
+		rest = rest[1+size:]
+		ok := true
+		switch directive {
+		case "Y":
+			year, ok = p.number(4)
+		case "y":
+			year, ok = p.number(2)
+			if year < 69 {
+				year += 2000
+			} else {
+				year += 1900
+			}
+		case "m":
+			month, ok = p.number(2)
+		case "d":
+			day, ok = p.number(2)
+		case "H":
+			hour, ok = p.number(2)
+		case "M":
+			minute, ok = p.number(2)
+		case "S":
+			second, ok = p.number(2)
+		case "f":
+			length := len(p.text)
+			nanosecond, ok = p.number(9)
+			for digits := length - len(p.text); digits < 9; digits++ {
+				nanosecond *= 10
+			}
+		case "j":
+			yearDay, ok = p.number(3)
+		case "u":
+			_, ok = p.number(1)
+		case "B", "OB", "b":
+			month, ok = p.name(MonthGenitiveNames, MonthNames, MonthShortNames)
+			month++
+		case "A", "a":
+			_, ok = p.name(WeekdayNames, WeekdayShortNames)
+		case "z":
+			var offset int
+			if offset, ok = p.offset(); ok {
+				location = time.FixedZone("", offset)
+			}
+		case "%":
+			ok = strings.HasPrefix(p.text, "%")
+			if ok {
+				p.text = p.text[1:]
+			}
+		case "Z":
+			return time.Time{}, NewValueErrorf("розбір назви часового поясу '%%Z' не підтримується")
+		default:
+			return time.Time{}, unknownDirective(directive)
+		}
+
+		if !ok {
+			return mismatch()
+		}
+	}
+
+	if len(p.text) != 0 {
+		return mismatch()
+	}
+
+	if yearDay == 0 {
+		return NewTime(year, month, day, hour, minute, second, nanosecond, location)
+	}
+
+	t, err := NewTime(year, 1, 1, hour, minute, second, nanosecond, location)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	t = t.AddDate(0, 0, yearDay-1)
+	if t.Year() != year {
+		return mismatch()
+	}
+
+	return t, nil
+}
+
+// NewTime creates the time checking the ranges of its parts.
+func NewTime(year, month, day, hour, minute, second, nanosecond int, location *time.Location) (time.Time, error) {
+	switch {
+	case month < 1 || month > 12:
+		return time.Time{}, NewValueErrorf("місяць має бути в діапазоні від 1 до 12, отримано %d", month)
+	case hour < 0 || hour > 23:
+		return time.Time{}, NewValueErrorf("година має бути в діапазоні від 0 до 23, отримано %d", hour)
+	case minute < 0 || minute > 59:
+		return time.Time{}, NewValueErrorf("хвилина має бути в діапазоні від 0 до 59, отримано %d", minute)
+	case second < 0 || second > 59:
+		return time.Time{}, NewValueErrorf("секунда має бути в діапазоні від 0 до 59, отримано %d", second)
+	case nanosecond < 0 || nanosecond > 999999999:
+		return time.Time{}, NewValueErrorf("наносекунда має бути в діапазоні від 0 до 999999999, отримано %d", nanosecond)
+	}
+
+	t := time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, location)
+	if t.Day() != day || int(t.Month()) != month {
+		return time.Time{}, NewValueErrorf("день %d поза межами місяця", day)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,204 @@
+package types
+
+import (
+	"time"
+)
+
+type dateTimeMethodFunc func(ctx Context, self DateTime, args Tuple) (Object, error)
+
+// newDateTimeMethod creates a method of 'дата_час' class, the first
+// parameter of which is the date itself.
+func newDateTimeMethod(
+	pkg *Package,
+	name string,
+	parameters []MethodParameter,
+	returnType *Class,
+	f dateTimeMethodFunc,
+) *Method {
+	return MethodNew(
+		name,
+		pkg,
+		append(
+			[]MethodParameter{
+				{
+					Class:      DateTimeClass,
+					Classes:    nil,
+					Name:       "я",
+					IsNullable: false,
+					IsVariadic: false,
+				},
+			},
+			parameters...,
+		),
+		[]MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: false,
+			},
+		},
+		func(ctx Context, args Tuple, _ StringDict) (Object, error) {
+			return f(ctx, args[0].(DateTime), args[1:])
+		},
+	)
+}
+
+// newDateTimePartMethod creates a method, which returns an integer
+// part of the date, i.e. the year.
+func newDateTimePartMethod(pkg *Package, name string, part func(t time.Time) int) *Method {
+	return newDateTimeMethod(
+		pkg, name, nil, IntClass, func(_ Context, self DateTime, _ Tuple) (Object, error) {
+			return Int(part(self.Time())), nil
+		},
+	)
+}
+
+// newZoneMethod creates a method, which changes the time zone of the
+// date to the zone with the name.
+func newZoneMethod(pkg *Package, name string, change func(t time.Time, location *time.Location) time.Time) *Method {
+	return newDateTimeMethod(
+		pkg, name, []MethodParameter{stringParameter("зона")}, DateTimeClass,
+		func(_ Context, self DateTime, args Tuple) (Object, error) {
+			location, err := LoadLocation(string(args[0].(String)))
+			if err != nil {
+				return nil, err
+			}
+
+			return DateTime(change(self.Time(), location)), nil
+		},
+	)
+}
+
+func MakeDateTimeClassMethods(pkg *Package) StringDict {
+	methods := []*Method{
+		newDateTimePartMethod(pkg, "рік", time.Time.Year),
+		newDateTimePartMethod(
+			pkg, "місяць", func(t time.Time) int {
+				return int(t.Month())
+			},
+		),
+		newDateTimePartMethod(pkg, "день", time.Time.Day),
+		newDateTimePartMethod(pkg, "година", time.Time.Hour),
+		newDateTimePartMethod(pkg, "хвилина", time.Time.Minute),
+		newDateTimePartMethod(pkg, "секунда", time.Time.Second),
+		newDateTimePartMethod(pkg, "наносекунда", time.Time.Nanosecond),
+		newDateTimePartMethod(pkg, "день_року", time.Time.YearDay),
+		newDateTimePartMethod(
+			pkg, "день_тижня", func(t time.Time) int {
+				return weekdayIndex(t) + 1
+			},
+		),
+		newDateTimeMethod(
+			pkg, "зона", nil, StringClass, func(_ Context, self DateTime, _ Tuple) (Object, error) {
+				return String(self.Time().Location().String()), nil
+			},
+		),
+		newDateTimeMethod(
+			pkg, "зміщення", nil, DurationClass, func(_ Context, self DateTime, _ Tuple) (Object, error) {
+				_, offset := self.Time().Zone()
+				return Duration(time.Duration(offset) * time.Second), nil
+			},
+		),
+		newZoneMethod(pkg, "у_зоні", time.Time.In),
+		newZoneMethod(
+			pkg, "з_зоною", func(t time.Time, location *time.Location) time.Time {
+				return time.Date(
+					t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), location,
+				)
+			},
+		),
+		newDateTimeMethod(
+			pkg, "дата", nil, DateTimeClass, func(_ Context, self DateTime, _ Tuple) (Object, error) {
+				t := self.Time()
+				return DateTime(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())), nil
+			},
+		),
+		newDateTimeMethod(
+			pkg, "мітка_часу", nil, RealClass, func(_ Context, self DateTime, _ Tuple) (Object, error) {
+				t := self.Time()
+				return Real(float64(t.Unix()) + float64(t.Nanosecond())/1e9), nil
+			},
+		),
+		newDateTimeMethod(
+			pkg, "форматувати", []MethodParameter{stringParameter("шаблон")}, StringClass,
+			func(_ Context, self DateTime, args Tuple) (Object, error) {
+				text, err := FormatTime(self.Time(), string(args[0].(String)))
+				if err != nil {
+					return nil, err
+				}
+
+				return String(text), nil
+			},
+		),
+	}
+
+	dict := StringDict{}
+	for _, method := range methods {
+		dict[method.Name] = method
+	}
+
+	return dict
+}
+
+// newDurationMethod creates a method of 'тривалість' class, which
+// converts the duration to a number.
+func newDurationMethod(pkg *Package, name string, returnType *Class, f func(self Duration) Object) *Method {
+	return MethodNew(
+		name,
+		pkg,
+		[]MethodParameter{
+			{
+				Class:      DurationClass,
+				Classes:    nil,
+				Name:       "я",
+				IsNullable: false,
+				IsVariadic: false,
+			},
+		},
+		[]MethodReturnType{
+			{
+				Class:      returnType,
+				IsNullable: false,
+			},
+		},
+		func(_ Context, args Tuple, _ StringDict) (Object, error) {
+			return f(args[0].(Duration)), nil
+		},
+	)
+}
+
+func MakeDurationClassMethods(pkg *Package) StringDict {
+	methods := []*Method{
+		newDurationMethod(
+			pkg, "години", RealClass, func(self Duration) Object {
+				return Real(time.Duration(self).Hours())
+			},
+		),
+		newDurationMethod(
+			pkg, "хвилини", RealClass, func(self Duration) Object {
+				return Real(time.Duration(self).Minutes())
+			},
+		),
+		newDurationMethod(
+			pkg, "секунди", RealClass, func(self Duration) Object {
+				return Real(self.Seconds())
+			},
+		),
+		newDurationMethod(
+			pkg, "мілісекунди", IntClass, func(self Duration) Object {
+				return Int(time.Duration(self).Milliseconds())
+			},
+		),
+		newDurationMethod(
+			pkg, "наносекунди", IntClass, func(self Duration) Object {
+				return Int(self)
+			},
+		),
+	}
+
+	dict := StringDict{}
+	for _, method := range methods {
+		dict[method.Name] = method
+	}
+
+	return dict
+}
@@ -11,14 +11,25 @@ func (value *Tuple) Class() *Class {
 }
 
 func TupleNew(ctx Context, cls *Class, args Tuple) (Object, error) {
-	// TODO: add iterators!
 	tuple := &Tuple{}
 	if len(args) == 1 {
 		switch arg := args[0].(type) {
 		case *List:
-			*tuple = arg.Values
+			*tuple = make(Tuple, len(arg.Values))
+			copy(*tuple, arg.Values)
+		case *Tuple:
+			*tuple = make(Tuple, len(*arg))
+			copy(*tuple, *arg)
 		default:
-			return nil, NewErrorf("об'єкт '%s' не є ітерованим", arg.Class().Name)
+			err := IterateOver(
+				ctx, arg, func(element Object) (bool, error) {
+					*tuple = append(*tuple, element)
+					return false, nil
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
 		}
 	} else if len(args) > 1 {
 		*tuple = args
@@ -32,39 +43,158 @@ func (value *Tuple) represent(ctx Context) (Object, error) {
 }
 
 func (value *Tuple) string(ctx Context) (Object, error) {
-	str := String("")
-	vLen := len(*value)
-	for i, item := range *value {
-		itemStr, err := Represent(ctx, item)
+	return representContainer(
+		value, "(...)", func() (Object, error) {
+			str := String("")
+			vLen := len(*value)
+			for i, item := range *value {
+				itemStr, err := Represent(ctx, item)
+				if err != nil {
+					return nil, err
+				}
+
+				str += itemStr.(String)
+				if i < vLen-1 {
+					str += ", "
+				}
+			}
+
+			if vLen == 1 {
+				str += ","
+			}
+
+			return String(fmt.Sprintf("(%s)", str)), nil
+		},
+	)
+}
+
+func (value *Tuple) toBool(_ Context) (Object, error) {
+	return gb2bo(len(*value) != 0), nil
+}
+
+func (value *Tuple) hash(ctx Context) (Object, error) {
+	result := fnvOffsetBasis
+	for _, item := range *value {
+		itemHash, err := Hash(ctx, item)
 		if err != nil {
 			return nil, err
 		}
 
-		str += itemStr.(String)
-		if i < vLen-1 {
-			str += ", "
+		result = (result ^ uint64(itemHash.(Int))) * fnvPrime
+	}
+
+	return Int(result), nil
+}
+
+func (value *Tuple) add(_ Context, other Object) (Object, error) {
+	if t, ok := other.(*Tuple); ok {
+		result := make(Tuple, 0, len(*value)+len(*t))
+		result = append(result, *value...)
+		result = append(result, *t...)
+		return &result, nil
+	}
+
+	return nil, NewErrorf("неможливо виконати конкатенацію кортежу з об'єктом '%s'", other.Class().Name)
+}
+
+func (value *Tuple) mul(_ Context, other Object) (Object, error) {
+	var count Int
+	switch otherValue := other.(type) {
+	case Int:
+		count = otherValue
+	case Bool:
+		count = bo2io(otherValue)
+	default:
+		return nil, NewErrorf("неможливо виконати множення кортежу на об'єкт '%s'", other.Class().Name)
+	}
+
+	result := Tuple{}
+	for i := Int(0); i < count; i++ {
+		result = append(result, *value...)
+	}
+
+	return &result, nil
+}
+
+func (value *Tuple) reversedMul(ctx Context, other Object) (Object, error) {
+	return value.mul(ctx, other)
+}
+
+func (value *Tuple) contains(ctx Context, item Object) (Object, error) {
+	return containsElement(ctx, *value, item)
+}
+
+func (value *Tuple) equals(ctx Context, other Object) (Object, error) {
+	if t, ok := other.(*Tuple); ok {
+		result, err := value.compareWith(ctx, t, true)
+		if err != nil {
+			return nil, err
 		}
+
+		return gb2bo(result == 0), nil
 	}
 
-	return String(fmt.Sprintf("(%s)", str)), nil
+	return False, nil
 }
 
-func (value *Tuple) equals(_ Context, other Object) (Object, error) {
+func (value *Tuple) notEquals(ctx Context, other Object) (Object, error) {
 	if t, ok := other.(*Tuple); ok {
-		vLen := len(*value)
-		if vLen != len(*t) {
-			return False, nil
+		result, err := value.compareWith(ctx, t, true)
+		if err != nil {
+			return nil, err
 		}
 
-		for i := 0; i < vLen; i++ {
-			// TODO: compare items!
+		return gb2bo(result != 0), nil
+	}
+
+	return True, nil
+}
+
+func (value *Tuple) less(ctx Context, other Object) (Object, error) {
+	return value.compare(ctx, other, "<", func(result int) bool { return result < 0 })
+}
+
+func (value *Tuple) lessOrEquals(ctx Context, other Object) (Object, error) {
+	return value.compare(ctx, other, "<=", func(result int) bool { return result <= 0 })
+}
+
+func (value *Tuple) greater(ctx Context, other Object) (Object, error) {
+	return value.compare(ctx, other, ">", func(result int) bool { return result > 0 })
+}
+
+func (value *Tuple) greaterOrEquals(ctx Context, other Object) (Object, error) {
+	return value.compare(ctx, other, ">=", func(result int) bool { return result >= 0 })
+}
+
+func (value *Tuple) compare(ctx Context, other Object, operator string, check func(int) bool) (Object, error) {
+	if t, ok := other.(*Tuple); ok {
+		result, err := value.compareWith(ctx, t, false)
+		if err != nil {
+			return nil, err
 		}
 
-		// TODO: remove!
-		return True, nil
+		return gb2bo(check(result)), nil
 	}
 
-	return False, nil
+	return nil, OperatorNotSupportedErrorNew(operator, value.Class().Name, other.Class().Name)
+}
+
+func (value *Tuple) compareWith(ctx Context, other *Tuple, equalityOnly bool) (int, error) {
+	return compareContainers(
+		value, other, func() (int, error) {
+			return compareSequences(ctx, *value, *other, equalityOnly)
+		},
+	)
+}
+
+func (value *Tuple) deepCopy(ctx Context, memo map[Object]Object) (Object, error) {
+	tuple := make(Tuple, len(*value))
+	memo[value] = &tuple
+	if err := deepCopyElements(ctx, tuple, *value, memo); err != nil {
+		return nil, err
+	}
+
+	return &tuple, nil
 }
 
 func (value *Tuple) Length(_ Context) (Int, error) {
@@ -106,13 +236,79 @@ func (value *Tuple) Slice(ctx Context, leftBound, rightBound Int) (Object, error
 		}
 	}
 
-	list := NewList()
+	tuple := Tuple{}
 	if leftBound > rightBound {
-		return list, nil
+		return &tuple, nil
+	}
+
+	slicedTuple := (*value)[leftBound:rightBound]
+	tuple = make(Tuple, len(slicedTuple))
+	copy(tuple, slicedTuple)
+	return &tuple, nil
+}
+
+// compareSequences compares two sequences element by element
+// and returns 0 if they are equal, -1 if 'a' is lexicographically
+// less than 'b', or 1 otherwise. If 'equalityOnly' is true, the
+// ordering of elements is not checked and 1 is returned for
+// sequences that are not equal.
+func compareSequences(ctx Context, a, b []Object, equalityOnly bool) (int, error) {
+	if equalityOnly && len(a) != len(b) {
+		return 1, nil
+	}
+
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if Is(a[i], b[i]) {
+			continue
+		}
+
+		equal, err := goBool(ctx, Equals, a[i], b[i])
+		if err != nil {
+			return 0, err
+		}
+
+		if equal {
+			continue
+		}
+
+		if equalityOnly {
+			return 1, nil
+		}
+
+		less, err := goBool(ctx, Less, a[i], b[i])
+		if err != nil {
+			return 0, err
+		}
+
+		if less {
+			return -1, nil
+		}
+
+		return 1, nil
+	}
+
+	switch {
+	case len(a) < len(b):
+		return -1, nil
+	case len(a) > len(b):
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// goBool applies binary operator to 'a' and 'b' and converts
+// the result to Go bool.
+func goBool(ctx Context, operator func(Context, Object, Object) (Object, error), a, b Object) (bool, error) {
+	result, err := operator(ctx, a, b)
+	if err != nil {
+		return false, err
+	}
+
+	result, err = ToBool(ctx, result)
+	if err != nil {
+		return false, err
 	}
 
-	slicedList := (*value)[leftBound:rightBound]
-	list.Values = make([]Object, len(slicedList))
-	copy(list.Values, slicedList)
-	return list, nil
+	return bool(result.(Bool)), nil
 }
@@ -1,12 +1,14 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/packages"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/interpreter"
@@ -25,7 +27,9 @@ var rootCmd = &cobra.Command{
 	Long: `Борщ — це мова програмування, яка дозволяє писати код українською.
 Вихідний код доступний на GitHub — https://github.com/YuriyLisovskiy/borsch-lang`,
 	Args: func(cmd *cobra.Command, args []string) error {
-		if len(args) > 0 {
+		// With the code given by the flag, all arguments are passed to
+		// the program.
+		if len(args) > 0 && len(codeArg) == 0 {
 			fileInfo, err := os.Stat(args[0])
 			if err != nil || fileInfo.IsDir() {
 				return fmt.Errorf("'%s' is not a file", args[0])
@@ -47,8 +51,10 @@ var rootCmd = &cobra.Command{
 		}
 
 		if len(codeArg) > 0 {
+			packages.SetArguments(args)
 			runCode(codeArg)
 		} else if len(args) > 0 {
+			packages.SetArguments(args[1:])
 			filePath, err := filepath.Abs(args[0])
 			if err != nil {
 				fmt.Println(err.Error())
@@ -95,6 +101,18 @@ func run(fn func(i interpreter.Interpreter) (types.Object, error)) {
 	state := interpreter.NewInitialState(nil, nil, stacktrace)
 	i := interpreter.NewInterpreter(parser, state)
 	_, err = fn(i)
+
+	// The standard output of the program is buffered, it must be written
+	// before the stack trace and the exit.
+	if flushErr := types.FlushStandardStreams(); flushErr != nil && err == nil {
+		err = flushErr
+	}
+
+	var exitErr *types.ExitError
+	if errors.As(err, &exitErr) {
+		os.Exit(exitErr.Code)
+	}
+
 	if err != nil {
 		if pErr, ok := err.(participle.UnexpectedTokenError); ok {
 			text := processParseError(pErr.Message())
@@ -107,6 +125,8 @@ func run(fn func(i interpreter.Interpreter) (types.Object, error)) {
 }
 
 func init() {
+	// Flags after the path to the script are arguments of the program.
+	rootCmd.Flags().SetInterspersed(false)
 	rootCmd.Flags().StringVarP(
 		&stdRoot, "lib", "l", "", "шлях до каталогу зі стандартною бібліотекою мови",
 	)
@@ -32,6 +32,9 @@ const (
 	LessOp
 	LessOrEqualsOp
 
+	// membership
+	ContainsOp
+
 	// other operators
 	ConstructorOp
 	CallOp
@@ -53,6 +56,10 @@ const (
 	RealOp
 	StringOp
 	RepresentationOp
+	IteratorOp
+	NextOp
+	CopyOp
+	DeepCopyOp
 )
 
 var opTypesToSignatures = map[OperatorHash]string{
@@ -75,6 +82,7 @@ var opTypesToSignatures = map[OperatorHash]string{
 	GreaterOrEqualsOp:   ">=",
 	LessOp:              "<",
 	LessOrEqualsOp:      "<=",
+	ContainsOp:          "__містить__",
 
 	ConstructorOp: "__конструктор__",
 	CallOp:        "__виклик__",
@@ -89,6 +97,10 @@ var opTypesToSignatures = map[OperatorHash]string{
 	RealOp:            "__дійсне__",
 	StringOp:          "__рядок__",
 	RepresentationOp:  "__представлення__",
+	IteratorOp:        "__ітератор__",
+	NextOp:            "__наступний__",
+	CopyOp:            "__копія__",
+	DeepCopyOp:        "__глибока_копія__",
 }
 
 var opSignaturesToHashes = map[string]OperatorHash{
@@ -112,6 +124,8 @@ var opSignaturesToHashes = map[string]OperatorHash{
 	"<":  LessOp,
 	"<=": LessOrEqualsOp,
 
+	"__містить__": ContainsOp,
+
 	"__конструктор__": ConstructorOp,
 	"__виклик__":      CallOp,
 
@@ -125,6 +139,10 @@ var opSignaturesToHashes = map[string]OperatorHash{
 	"__дійсне__":        RealOp,
 	"__рядок__":         StringOp,
 	"__представлення__": RepresentationOp,
+	"__ітератор__":      IteratorOp,
+	"__наступний__":     NextOp,
+	"__копія__":         CopyOp,
+	"__глибока_копія__": DeepCopyOp,
 }
 
 var opNames = []string{
@@ -148,6 +166,8 @@ var opNames = []string{
 	"<",  // <
 	"<=", // <=
 
+	"__містить__",
+
 	"__конструктор__",
 	"__виклик__",
 
@@ -161,6 +181,10 @@ var opNames = []string{
 	"__дійсне__",
 	"__рядок__",
 	"__представлення__",
+	"__ітератор__",
+	"__наступний__",
+	"__копія__",
+	"__глибока_копія__",
 }
 
 func OperatorHashFromString(signature string) OperatorHash {
@@ -181,7 +205,7 @@ func (op OperatorHash) IsUnary() bool {
 }
 
 func (op OperatorHash) IsBinary() bool {
-	return op <= LessOrEqualsOp
+	return op <= ContainsOp
 }
 
 func (op OperatorHash) Sign() string {
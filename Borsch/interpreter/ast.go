@@ -66,19 +66,30 @@ type LoopStmt struct {
 }
 
 // RangeBasedLoop is a loop with two bounds to
-// iterate over.
+// iterate over, or a loop over elements of a sequence
+// if the right bound is omitted. If more than one
+// variable is specified, each element of the sequence
+// is unpacked into these variables.
 //
 // Example:
 //   цикл (і : 1 .. 7)
 //   {
 //   }
+//
+//   цикл (елемент : (1, 2, 3))
+//   {
+//   }
+//
+//   цикл (ім_я, вік : [("Тарас", 47), ("Леся", 42)])
+//   {
+//   }
 type RangeBasedLoop struct {
 	Pos lexer.Position
 
-	Variable   Ident       `@Ident ":"`
+	Variables  []Ident     `@Ident ("," @Ident)* ":"`
 	LeftBound  *Expression `@@`
-	Separator  string      `@("."".")`
-	RightBound *Expression `@@`
+	Separator  string      `[ @("."".")`
+	RightBound *Expression `  @@ ]`
 }
 
 // ConditionalLoop
@@ -193,7 +204,7 @@ type ClassMember struct {
 type OperatorDef struct {
 	Pos lexer.Position
 
-	Op            string         `"оператор" @("=""=" | "!""=" | "<""=" | "<""<" | "<" | ">""=" | ">"">" | ">" | "+" | "-" | "/" | "*""*" | "*" | "%" | "^" | "~" | "&""&" | "&" | "|""|" | "|" | "__конструктор__" | "__виклик__" | "__довжина__" | "__логічне__" | "__ціле__" | "__дійсне__" | "__рядок__" | "__представлення__")`
+	Op            string         `"оператор" @("=""=" | "!""=" | "<""=" | "<""<" | "<" | ">""=" | ">"">" | ">" | "+" | "-" | "/" | "*""*" | "*" | "%" | "^" | "~" | "&""&" | "&" | "|""|" | "|" | "__конструктор__" | "__виклик__" | "__довжина__" | "__логічне__" | "__ціле__" | "__дійсне__" | "__рядок__" | "__представлення__" | "__містить__" | "__ітератор__" | "__наступний__" | "__копія__" | "__глибока_копія__")`
 	ParametersSet *ParametersSet `@@`
 	ReturnTypes   []*ReturnType  `[":" (@@ | ("(" (@@ ("," @@)+ )? ")"))]`
 	Body          *FunctionBody  `@@ "кінець"`
@@ -210,7 +221,20 @@ type Assignment struct {
 type Expression struct {
 	Pos lexer.Position
 
-	LogicalAnd *LogicalAnd `@@`
+	NilCoalescing *NilCoalescing `@@`
+}
+
+// NilCoalescing returns the left operand if it is not 'нуль',
+// otherwise evaluates and returns the right one.
+//
+// Example:
+//   ім_я = користувач?.ім_я ?? "анонім";
+type NilCoalescing struct {
+	Pos lexer.Position
+
+	LogicalAnd *LogicalAnd    `@@`
+	Op         string         `[ @("?""?")`
+	Next       *NilCoalescing `  @@ ]`
 }
 
 type LogicalAnd struct {
@@ -241,7 +265,7 @@ type Comparison struct {
 	Pos lexer.Position
 
 	BitwiseOr *BitwiseOr  `@@`
-	Op        string      `[ @(">""=" | ">" | "<""=" | "<" | "=""=" | "!""=")`
+	Op        string      `[ @(">""=" | ">" | "<""=" | "<" | "=""=" | "!""=" | "в" | "не""в" | "є" | "не""є")`
 	Next      *Comparison `  @@ ]`
 }
 
@@ -309,22 +333,48 @@ type Exponent struct {
 	Next    *Exponent `  @@ ]`
 }
 
+// Primary
+//
+// A parenthesised expression is a tuple literal if it
+// contains a comma, a sub-expression otherwise.
+//
+// Literals and parenthesised expressions can be followed
+// by subscriptions and attributes.
+//
+// Example:
+//   (1 + 2)           // sub-expression
+//   ()                // empty tuple
+//   (1,)              // tuple with single element
+//   (1, 2)            // tuple
+//   "борщ"[0]         // subscription of literal
+//   ", ".з_єднати(с)  // attribute of literal
+//   (1, 2)[0]         // subscription of tuple
+//   (1, 2).довжина()  // attribute of tuple
 type Primary struct {
 	Pos lexer.Position
 
-	Literal         *Literal         `  @@`
-	LambdaDef       *LambdaDef       `| @@`
-	AttributeAccess *AttributeAccess `| @@`
-	SubExpression   *Expression      `| "(" @@ ")"`
+	Literal                 *Literal               `  @@`
+	LiteralSubscription     *SlicingOrSubscription `  @@?`
+	LiteralAttribute        *AttributeAccess       `  [ "." @@ ]`
+	LambdaDef               *LambdaDef             `| @@`
+	AttributeAccess         *AttributeAccess       `| @@`
+	EmptyTuple              bool                   `| ( @("(" ")")`
+	SubExpression           *Expression            `  | "(" @@`
+	IsTuple                 bool                   `    [ @","`
+	TupleTail               []*Expression          `      [ @@ ("," @@)* ","? ] ] ")" )`
+	ParenthesisSubscription *SlicingOrSubscription `  @@?`
+	ParenthesisAttribute    *AttributeAccess       `  [ "." @@ ]`
 }
 
 type Literal struct {
 	Pos lexer.Position
 
 	Nil             bool               `  @"нуль"`
+	Imaginary       *string            `| @(Float | Int) "і"`
 	Integer         *string            `| @Int`
 	Real            *string            `| @Float`
 	Bool            *Boolean           `| @("істина" | "хиба")`
+	FormattedString *FormattedString   `| "ф" @String`
 	StringValue     *string            `| @String`
 	MultilineString *string            `| @RawString`
 	List            []*Expression      `| "[" @@ ("," @@)* "]"`
@@ -341,11 +391,45 @@ func (b *Boolean) Capture(values []string) error {
 	return nil
 }
 
+// FormattedString is a string literal with expressions in curly braces,
+// which are replaced with their string values. An expression can be
+// followed by a format specifier, the same as in 'формат()' built-in.
+// Double curly braces are used to write the braces themselves.
+//
+// Example:
+//   ф"Привіт, {ім_я}!"
+//   ф"{число:08.3f} {{дужки}}"
+type FormattedString struct {
+	Parts []*FormattedStringPart
+}
+
+type FormattedStringPart struct {
+	Text       string
+	Expression *Expression
+	Spec       string
+}
+
+func (s *FormattedString) Capture(values []string) error {
+	parts, err := parseFormattedString(values[0])
+	if err != nil {
+		return err
+	}
+
+	s.Parts = parts
+	return nil
+}
+
+// DictionaryEntry is a key-value pair of the dictionary literal or,
+// if the value is omitted, an element of the set literal.
+//
+// Example:
+//   {"ключ": "значення"}
+//   {1, 2, 3}
 type DictionaryEntry struct {
 	Pos lexer.Position
 
 	Key   *Expression `@@`
-	Value *Expression `":" @@`
+	Value *Expression `[ ":" @@ ]`
 }
 
 type LambdaDef struct {
@@ -358,11 +442,20 @@ type LambdaDef struct {
 	InstantCallArguments []*Expression  `[(@@ ("," @@)*)?] ")"]`
 }
 
+// AttributeAccess
+//
+// If IsNilSafe is true and the current value is 'нуль',
+// the rest of the chain is not evaluated and 'нуль' is returned.
+// Assignment through such chain is an error.
+//
+// Example:
+//   вулиця = користувач?.адреса.вулиця;
 type AttributeAccess struct {
 	Pos lexer.Position
 
 	IdentOrCall     *IdentOrCall     `@@`
-	AttributeAccess *AttributeAccess `("." @@)?`
+	IsNilSafe       bool             `[ (@("?"".") | ".")`
+	AttributeAccess *AttributeAccess `  @@ ]`
 }
 
 type IdentOrCall struct {
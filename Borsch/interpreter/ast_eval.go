@@ -2,6 +2,7 @@ package interpreter
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
@@ -17,7 +18,7 @@ func (node *Package) Evaluate(state State) (types.Object, error) {
 		state.Trace(node.Stmts, "<пакет>")
 	}
 
-	return result.Value, result.Err
+	return result.Value, exitError(result)
 }
 
 // Evaluate executes block of statements.
@@ -25,7 +26,7 @@ func (node *Package) Evaluate(state State) (types.Object, error) {
 func (node *BlockStmts) Evaluate(state State, inFunction, inLoop bool) StmtResult {
 	node.stmtPos = 0
 	for _, stmt := range node.Stmts {
-		result := stmt.Evaluate(state, inFunction, inLoop)
+		result := exitResult(stmt.Evaluate(state, inFunction, inLoop))
 		if result.Interrupt() {
 			if callErr, ok := result.Err.(utilities.CallError); ok {
 				state.Trace(stmt, callErr.Function())
@@ -42,8 +43,8 @@ func (node *BlockStmts) Evaluate(state State, inFunction, inLoop bool) StmtResul
 }
 
 func (node *Expression) Evaluate(state State, valueToSet types.Object) (types.Object, error) {
-	if node.LogicalAnd != nil {
-		return node.LogicalAnd.Evaluate(state, valueToSet)
+	if node.NilCoalescing != nil {
+		return node.NilCoalescing.Evaluate(state, valueToSet)
 	}
 
 	panic("unreachable")
@@ -66,6 +67,29 @@ func (node *Assignment) Evaluate(state State) (types.Object, error) {
 	return value, nil
 }
 
+// Evaluate executes NilCoalescing operation.
+// The right-hand operand is evaluated only if the left-hand one is 'нуль'.
+func (node *NilCoalescing) Evaluate(state State, valueToSet types.Object) (types.Object, error) {
+	if node.Next == nil {
+		return node.LogicalAnd.Evaluate(state, valueToSet)
+	}
+
+	if valueToSet != nil {
+		return nil, utilities.SyntaxError("неможливо записати значення у вираз")
+	}
+
+	left, err := node.LogicalAnd.Evaluate(state, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if left != types.Nil {
+		return left, nil
+	}
+
+	return node.Next.Evaluate(state, nil)
+}
+
 // Evaluate executes LogicalAnd operation.
 // If `valueToSet` is nil, return variable or value from context,
 // set a new value or return an error otherwise.
@@ -108,11 +132,42 @@ func (node *Comparison) Evaluate(state State, valueToSet types.Object) (types.Ob
 		return evalBinaryOperator(state, valueToSet, types.Equals, node.BitwiseOr, node.Next)
 	case "!=":
 		return evalBinaryOperator(state, valueToSet, types.NotEquals, node.BitwiseOr, node.Next)
+	case "в":
+		return evalBinaryOperator(state, valueToSet, evalContains, node.BitwiseOr, node.Next)
+	case "нев":
+		return evalBinaryOperator(state, valueToSet, evalNotContains, node.BitwiseOr, node.Next)
+	case "є":
+		return evalBinaryOperator(state, valueToSet, evalIs, node.BitwiseOr, node.Next)
+	case "неє":
+		return evalBinaryOperator(state, valueToSet, evalIsNot, node.BitwiseOr, node.Next)
 	default:
 		return node.BitwiseOr.Evaluate(state, valueToSet)
 	}
 }
 
+// evalContains checks whether the container, i.e. the right operand,
+// includes the item, i.e. the left operand.
+func evalContains(ctx types.Context, item, container types.Object) (types.Object, error) {
+	return types.Contains(ctx, container, item)
+}
+
+func evalNotContains(ctx types.Context, item, container types.Object) (types.Object, error) {
+	result, err := types.Contains(ctx, container, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return !result.(types.Bool), nil
+}
+
+func evalIs(_ types.Context, a, b types.Object) (types.Object, error) {
+	return types.Is(a, b), nil
+}
+
+func evalIsNot(_ types.Context, a, b types.Object) (types.Object, error) {
+	return !types.Is(a, b), nil
+}
+
 func (node *BitwiseOr) Evaluate(state State, valueToSet types.Object) (types.Object, error) {
 	return evalBinaryOperator(state, valueToSet, types.BitwiseOr, node.BitwiseXor, node.Next)
 }
@@ -178,12 +233,21 @@ func (node *Exponent) Evaluate(state State, valueToSet types.Object) (types.Obje
 }
 
 func (node *Primary) Evaluate(state State, valueToSet types.Object) (types.Object, error) {
-	if node.SubExpression != nil {
+	if node.EmptyTuple || node.SubExpression != nil {
+		if node.ParenthesisSubscription == nil && node.ParenthesisAttribute == nil {
+			return node.evalParenthesis(state, valueToSet)
+		}
+
 		if valueToSet != nil {
 			return nil, utilities.SyntaxError("неможливо записати значення у вираз")
 		}
 
-		return node.SubExpression.Evaluate(state, valueToSet)
+		value, err := node.evalParenthesis(state, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return evalSuffixes(state, value, node.ParenthesisSubscription, node.ParenthesisAttribute)
 	}
 
 	if node.Literal != nil {
@@ -191,7 +255,12 @@ func (node *Primary) Evaluate(state State, valueToSet types.Object) (types.Objec
 			return nil, utilities.SyntaxError("неможливо встановити значення у літерал")
 		}
 
-		return node.Literal.Evaluate(state, valueToSet)
+		value, err := node.Literal.Evaluate(state, valueToSet)
+		if err != nil {
+			return nil, err
+		}
+
+		return evalSuffixes(state, value, node.LiteralSubscription, node.LiteralAttribute)
 	}
 
 	if node.AttributeAccess != nil {
@@ -205,11 +274,97 @@ func (node *Primary) Evaluate(state State, valueToSet types.Object) (types.Objec
 	panic("unreachable")
 }
 
+// evalParenthesis evaluates the empty tuple, the tuple literal or the
+// sub-expression.
+func (node *Primary) evalParenthesis(state State, valueToSet types.Object) (types.Object, error) {
+	if node.EmptyTuple {
+		if valueToSet != nil {
+			return nil, utilities.SyntaxError("неможливо встановити значення у літерал")
+		}
+
+		return &types.Tuple{}, nil
+	}
+
+	if node.IsTuple {
+		expressions := append([]*Expression{node.SubExpression}, node.TupleTail...)
+		if valueToSet != nil {
+			// unpack right-hand sequence into tuple of variables:
+			//  (а, б) = 1, 2;
+			return unpackFromSequence(state, expressions, valueToSet, false)
+		}
+
+		tuple := types.Tuple{}
+		if err := updateArgs(state, expressions, &tuple); err != nil {
+			return nil, err
+		}
+
+		return &tuple, nil
+	}
+
+	if valueToSet != nil {
+		return nil, utilities.SyntaxError("неможливо записати значення у вираз")
+	}
+
+	return node.SubExpression.Evaluate(state, valueToSet)
+}
+
+// evalSuffixes applies the optional subscription and then the optional
+// attribute access to the value of the literal or the parenthesised
+// expression.
+func evalSuffixes(
+	state State,
+	value types.Object,
+	subscription *SlicingOrSubscription,
+	attribute *AttributeAccess,
+) (types.Object, error) {
+	if subscription != nil {
+		var err error
+		value, err = subscription.Evaluate(state, value, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if attribute != nil {
+		return attribute.Evaluate(state, nil, value)
+	}
+
+	return value, nil
+}
+
+func (node *FormattedString) Evaluate(state State) (types.Object, error) {
+	var builder strings.Builder
+	for _, part := range node.Parts {
+		if part.Expression == nil {
+			builder.WriteString(part.Text)
+			continue
+		}
+
+		value, err := part.Expression.Evaluate(state, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		str, err := types.Format(state.Context(), value, part.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WriteString(string(str.(types.String)))
+	}
+
+	return types.String(builder.String()), nil
+}
+
 func (node *Literal) Evaluate(state State, valueToSet types.Object) (types.Object, error) {
 	if node.Nil {
 		return types.Nil, nil
 	}
 
+	if node.Imaginary != nil {
+		return types.ImaginaryFromString(*node.Imaginary)
+	}
+
 	if node.Integer != nil {
 		return types.IntFromString(*node.Integer, 0)
 	}
@@ -222,6 +377,10 @@ func (node *Literal) Evaluate(state State, valueToSet types.Object) (types.Objec
 		return types.NewBool(bool(*node.Bool)), nil
 	}
 
+	if node.FormattedString != nil {
+		return node.FormattedString.Evaluate(state)
+	}
+
 	if node.StringValue != nil {
 		return types.String(*node.StringValue), nil
 	}
@@ -256,30 +415,62 @@ func (node *Literal) Evaluate(state State, valueToSet types.Object) (types.Objec
 	// 	return node.SubExpression.Evaluate(state, valueToSet)
 	// }
 
-	// if node.Dictionary != nil {
-	// 	dict := types.NewDictionaryInstance()
-	// 	for _, entry := range node.Dictionary {
-	// 		key, value, err := entry.Evaluate(state)
-	// 		if err != nil {
-	// 			return nil, err
-	// 		}
-	//
-	// 		if err := dict.SetElement(key, value); err != nil {
-	// 			return nil, err
-	// 		}
-	// 	}
-	//
-	// 	return dict, nil
-	// }
+	if node.Dictionary != nil {
+		if node.Dictionary[0].Value == nil {
+			return evalSetLiteral(state, node.Dictionary)
+		}
 
-	// if node.EmptyDictionary {
-	// 	return types.NewDictionaryInstance(), nil
-	// }
+		dict := types.NewDictionary()
+		for _, entry := range node.Dictionary {
+			key, value, err := entry.Evaluate(state)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err := dict.SetItem(state.Context(), key, value); err != nil {
+				return nil, err
+			}
+		}
+
+		return dict, nil
+	}
+
+	if node.EmptyDictionary {
+		return types.NewDictionary(), nil
+	}
 
 	panic("unreachable")
 }
 
+var errMixedDictionaryAndSet = utilities.SyntaxError(
+	"неможливо поєднувати пари словника та елементи множини в одному літералі",
+)
+
+func evalSetLiteral(state State, entries []*DictionaryEntry) (types.Object, error) {
+	set := types.NewSet()
+	for _, entry := range entries {
+		if entry.Value != nil {
+			return nil, errMixedDictionaryAndSet
+		}
+
+		value, err := entry.Key.Evaluate(state, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := set.Add(state.Context(), value); err != nil {
+			return nil, err
+		}
+	}
+
+	return set, nil
+}
+
 func (node *DictionaryEntry) Evaluate(state State) (types.Object, types.Object, error) {
+	if node.Value == nil {
+		return nil, nil, errMixedDictionaryAndSet
+	}
+
 	key, err := node.Key.Evaluate(state, nil)
 	if err != nil {
 		return nil, nil, err
@@ -311,6 +502,14 @@ func (node *AttributeAccess) Evaluate(state State, valueToSet, prevValue types.O
 				return nil, err
 			}
 
+			// The assignment can not be skipped silently, so the
+			// chain with '?.' fails if the value is 'нуль'.
+			if node.IsNilSafe && currentValue == types.Nil {
+				return nil, types.NewAttributeErrorf(
+					"неможливо встановити атрибут нульового значення через '?.'",
+				)
+			}
+
 			currentValue, err = node.AttributeAccess.Evaluate(state, valueToSet, currentValue)
 		} else {
 			currentValue, err = node.IdentOrCall.Evaluate(state, valueToSet, prevValue)
@@ -330,6 +529,10 @@ func (node *AttributeAccess) Evaluate(state State, valueToSet, prevValue types.O
 	}
 
 	if node.AttributeAccess != nil {
+		if node.IsNilSafe && currentValue == types.Nil {
+			return types.Nil, nil
+		}
+
 		return node.AttributeAccess.Evaluate(state, valueToSet, currentValue)
 	}
 
@@ -433,11 +636,6 @@ func (node *SlicingOrSubscription) Evaluate(
 ) (types.Object, error) {
 	if valueToSet != nil {
 		// set
-		rangesLen := len(node.Ranges)
-		if rangesLen != 0 && node.Ranges[rangesLen-1].RightBound != nil {
-			return nil, errors.New("неможливо присвоїти значення зрізу")
-		}
-
 		if len(node.Ranges) != 0 {
 			return evalSlicingOperation(state, variable, node.Ranges, valueToSet)
 		}
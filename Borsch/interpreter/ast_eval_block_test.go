@@ -11,22 +11,24 @@ import (
 func makeThrowStmt(name *Ident) *Throw {
 	return &Throw{
 		Expression: &Expression{
-			LogicalAnd: &LogicalAnd{
-				LogicalOr: &LogicalOr{
-					LogicalNot: &LogicalNot{
-						Comparison: &Comparison{
-							BitwiseOr: &BitwiseOr{
-								BitwiseXor: &BitwiseXor{
-									BitwiseAnd: &BitwiseAnd{
-										BitwiseShift: &BitwiseShift{
-											Addition: &Addition{
-												MultiplicationOrMod: &MultiplicationOrMod{
-													Unary: &Unary{
-														Exponent: &Exponent{
-															Primary: &Primary{
-																AttributeAccess: &AttributeAccess{
-																	IdentOrCall: &IdentOrCall{
-																		Ident: name,
+			NilCoalescing: &NilCoalescing{
+				LogicalAnd: &LogicalAnd{
+					LogicalOr: &LogicalOr{
+						LogicalNot: &LogicalNot{
+							Comparison: &Comparison{
+								BitwiseOr: &BitwiseOr{
+									BitwiseXor: &BitwiseXor{
+										BitwiseAnd: &BitwiseAnd{
+											BitwiseShift: &BitwiseShift{
+												Addition: &Addition{
+													MultiplicationOrMod: &MultiplicationOrMod{
+														Unary: &Unary{
+															Exponent: &Exponent{
+																Primary: &Primary{
+																	AttributeAccess: &AttributeAccess{
+																		IdentOrCall: &IdentOrCall{
+																			Ident: name,
+																		},
 																	},
 																},
 															},
@@ -328,3 +330,37 @@ func TestUnsafe_EvaluateThrownRethrownAndNotCaught(t *testing.T) {
 		t.Error("result value is not expected error")
 	}
 }
+
+func TestBlock_EvaluateExitIsNotCaught(t *testing.T) {
+	code := `с = імпорт("!/система");
+функція вийти_з_блоку(): ціле
+    блок
+        цикл (і : 0 .. 3)
+            с.вийти(3);
+        кінець;
+    піймати (п: Помилка)
+        повернути 1;
+    кінець;
+
+    повернути 2;
+кінець;
+
+блок
+    вийти_з_блоку();
+піймати (п: Помилка)
+кінець;
+
+с.вийти(4);
+`
+
+	i := makeTestInterpreter(t)
+	_, err := i.Evaluate("тест", code, nil)
+	exitErr, ok := err.(*types.ExitError)
+	if !ok {
+		t.Fatalf("Assertion failed:\nActual:\n%v\n\nExpected:\n*types.ExitError", err)
+	}
+
+	if exitErr.Code != 3 {
+		t.Errorf("Assertion failed:\nActual:\n%d\n\nExpected:\n3", exitErr.Code)
+	}
+}
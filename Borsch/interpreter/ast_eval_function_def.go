@@ -71,7 +71,7 @@ func (node *Parameter) Evaluate(ctx types.Context) (*types.MethodParameter, erro
 
 func (node *FunctionBody) Evaluate(state State) (types.Object, error) {
 	result := node.Stmts.Evaluate(state, true, false)
-	return result.Value, result.Err
+	return result.Value, exitError(result)
 }
 
 func (node *ReturnType) Evaluate(ctx types.Context) (*types.MethodReturnType, error) {
@@ -92,17 +92,12 @@ func (node *ReturnStmt) Evaluate(state State) (types.Object, error) {
 	case resultCount == 1:
 		return node.Expressions[0].Evaluate(state, nil)
 	case resultCount > 1:
-		// result := types.NewListInstance()
-		// for _, expression := range node.Expressions {
-		// 	value, err := expression.Evaluate(state, nil)
-		// 	if err != nil {
-		// 		return nil, err
-		// 	}
-		//
-		// 	result.Values = append(result.Values, value)
-		// }
-		//
-		// return result, nil
+		result := types.Tuple{}
+		if err := updateArgs(state, node.Expressions, &result); err != nil {
+			return nil, err
+		}
+
+		return &result, nil
 	}
 
 	panic("unreachable")
@@ -18,6 +18,14 @@ func (node *LoopStmt) Evaluate(state State, inFunction, inLoop bool) StmtResult
 }
 
 func (node *RangeBasedLoop) Evaluate(state State, body *BlockStmts, inFunction bool) StmtResult {
+	if node.RightBound == nil {
+		return node.evalIteration(state, body, inFunction)
+	}
+
+	if len(node.Variables) != 1 {
+		return StmtResult{Err: errors.New("цикл з межами приймає лише одну змінну")}
+	}
+
 	leftBound, err := getBound(state, node.LeftBound, "ліва")
 	if err != nil {
 		return StmtResult{Err: err}
@@ -30,7 +38,7 @@ func (node *RangeBasedLoop) Evaluate(state State, body *BlockStmts, inFunction b
 
 	ctx := state.Context()
 	for leftBound < rightBound {
-		ctx.PushScope(Scope{node.Variable.String(): types.Int(leftBound)})
+		ctx.PushScope(Scope{node.Variables[0].String(): types.Int(leftBound)})
 		result := body.Evaluate(state, inFunction, true)
 		ctx.PopScope()
 		if result.Interrupt() {
@@ -47,6 +55,80 @@ func (node *RangeBasedLoop) Evaluate(state State, body *BlockStmts, inFunction b
 	return StmtResult{}
 }
 
+func (node *RangeBasedLoop) evalIteration(state State, body *BlockStmts, inFunction bool) StmtResult {
+	value, err := node.LeftBound.Evaluate(state, nil)
+	if err != nil {
+		return StmtResult{Err: err}
+	}
+
+	ctx := state.Context()
+	result := StmtResult{}
+	err = types.IterateOver(
+		ctx, value, func(element types.Object) (bool, error) {
+			scope, err := node.makeScope(ctx, element)
+			if err != nil {
+				return false, err
+			}
+
+			ctx.PushScope(scope)
+			result = body.Evaluate(state, inFunction, true)
+			ctx.PopScope()
+			return result.Interrupt(), nil
+		},
+	)
+	if err != nil {
+		return StmtResult{Err: err}
+	}
+
+	if !result.Interrupt() {
+		return StmtResult{}
+	}
+
+	if result.State == StmtBreak {
+		result.State = StmtNone
+	}
+
+	return result
+}
+
+// makeScope creates a scope of the loop's body for the current
+// element. The element is unpacked if the loop has more than
+// one variable.
+func (node *RangeBasedLoop) makeScope(ctx types.Context, element types.Object) (Scope, error) {
+	if len(node.Variables) == 1 {
+		return Scope{node.Variables[0].String(): element}, nil
+	}
+
+	sequence, ok := element.(types.ISequence)
+	if !ok {
+		return nil, types.NewErrorf(
+			"неможливо розпакувати об'єкт з типом '%s', оскільки він не є послідовністю",
+			element.Class().Name,
+		)
+	}
+
+	length, err := sequence.Length(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkValuesCountToUnpack(int64(len(node.Variables)), int64(length)); err != nil {
+		return nil, err
+	}
+
+	scope := Scope{}
+	for i, variable := range node.Variables {
+		item, err := sequence.GetElement(ctx, types.Int(i))
+		if err != nil {
+			return nil, err
+		}
+
+		scope[variable.String()] = item
+	}
+
+	return scope, nil
+}
+
 func (node *ConditionalLoop) Evaluate(state State, body *BlockStmts, inFunction bool) StmtResult {
 	ctx := state.Context()
 	for {
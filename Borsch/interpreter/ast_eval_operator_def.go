@@ -82,10 +82,12 @@ func checkOperator(
 	switch opHash {
 	case common.LengthOp, common.IntOp:
 		return checkSingleReturnType(returnTypes, types.IntClass, opHash)
-	case common.BoolOp:
+	case common.BoolOp, common.ContainsOp:
 		return checkSingleReturnType(returnTypes, types.BoolClass, opHash)
 	case common.StringOp, common.RepresentationOp:
 		return checkSingleReturnType(returnTypes, types.StringClass, opHash)
+	case common.CopyOp, common.DeepCopyOp:
+		return checkSingleReturnType(returnTypes, class, opHash)
 	}
 
 	return nil
@@ -18,6 +18,8 @@ func (s StmtState) String() string {
 		return "StmtForceReturn"
 	case StmtThrow:
 		return "StmtThrown"
+	case StmtExit:
+		return "StmtExit"
 	default:
 		return ""
 	}
@@ -28,6 +30,7 @@ const (
 	StmtBreak
 	StmtForceReturn
 	StmtThrow
+	StmtExit
 )
 
 type StmtResult struct {
@@ -37,20 +40,42 @@ type StmtResult struct {
 }
 
 // Interrupt returns true when statement result contains
-// and error, or has StmtForceReturn or StmtBreak state.
+// and error, or has StmtForceReturn, StmtBreak or StmtExit state.
 func (r StmtResult) Interrupt() bool {
 	if r.Err != nil {
 		return true
 	}
 
 	switch r.State {
-	case StmtForceReturn, StmtBreak:
+	case StmtForceReturn, StmtBreak, StmtExit:
 		return true
 	}
 
 	return false
 }
 
+// exitResult turns the exit error of 'вийти()' into the result with
+// StmtExit state, so handler blocks do not catch it. Other results are
+// returned unchanged.
+func exitResult(result StmtResult) StmtResult {
+	var exitErr *types.ExitError
+	if errors.As(result.Err, &exitErr) {
+		return StmtResult{State: StmtExit, Value: types.Int(exitErr.Code)}
+	}
+
+	return result
+}
+
+// exitError turns the result with StmtExit state back into the exit
+// error, which unwinds calls of functions and packages.
+func exitError(result StmtResult) error {
+	if result.State == StmtExit {
+		return &types.ExitError{Code: int(result.Value.(types.Int))}
+	}
+
+	return result.Err
+}
+
 // Evaluate executes statement.
 // Returns (result value, force stop flag, error)
 func (node *Stmt) Evaluate(state State, inFunction, inLoop bool) StmtResult {
@@ -74,7 +74,11 @@ func (node *Assignment) String() string {
 }
 
 func (node *Expression) String() string {
-	return node.LogicalAnd.String()
+	return node.NilCoalescing.String()
+}
+
+func (node *NilCoalescing) String() string {
+	return node.LogicalAnd.String() + nextOrEmpty(node.Op, node.Next)
 }
 
 func (node *LogicalAnd) String() string {
@@ -86,11 +90,23 @@ func (node *LogicalOr) String() string {
 }
 
 func (node *LogicalNot) String() string {
-	return node.Comparison.String() + nextOrEmpty(node.Op, node.Next)
+	if node.Next != nil {
+		return node.Op + node.Next.String()
+	}
+
+	return node.Comparison.String()
 }
 
 func (node *Comparison) String() string {
-	return node.BitwiseOr.String() + nextOrEmpty(node.Op, node.Next)
+	op := node.Op
+	switch op {
+	case "нев":
+		op = "не в"
+	case "неє":
+		op = "не є"
+	}
+
+	return node.BitwiseOr.String() + nextOrEmpty(op, node.Next)
 }
 
 func (node *BitwiseOr) String() string {
@@ -132,22 +148,71 @@ func (node *Exponent) String() string {
 func (node *Primary) String() string {
 	switch {
 	case node.Literal != nil:
-		return node.Literal.String()
+		return suffixesString(node.Literal.String(), node.LiteralSubscription, node.LiteralAttribute)
 	case node.LambdaDef != nil:
 		return node.LambdaDef.String()
 	case node.AttributeAccess != nil:
 		return node.AttributeAccess.String()
+	case node.EmptyTuple:
+		return suffixesString("()", node.ParenthesisSubscription, node.ParenthesisAttribute)
+	case node.IsTuple:
+		values := []string{node.SubExpression.String()}
+		for _, expr := range node.TupleTail {
+			values = append(values, expr.String())
+		}
+
+		str := "(" + strings.Join(values, ", ") + ")"
+		if len(values) == 1 {
+			str = fmt.Sprintf("(%s,)", values[0])
+		}
+
+		return suffixesString(str, node.ParenthesisSubscription, node.ParenthesisAttribute)
 	case node.SubExpression != nil:
-		return fmt.Sprintf("(%s)", node.SubExpression.String())
+		str := fmt.Sprintf("(%s)", node.SubExpression.String())
+		return suffixesString(str, node.ParenthesisSubscription, node.ParenthesisAttribute)
 	default:
 		panic("unreachable")
 	}
 }
 
+func suffixesString(str string, subscription *SlicingOrSubscription, attribute *AttributeAccess) string {
+	if subscription != nil {
+		str += subscription.String()
+	}
+
+	if attribute != nil {
+		str += "." + attribute.String()
+	}
+
+	return str
+}
+
+func (node *FormattedString) String() string {
+	var builder strings.Builder
+	for _, part := range node.Parts {
+		if part.Expression == nil {
+			text := strings.ReplaceAll(part.Text, "{", "{{")
+			builder.WriteString(strings.ReplaceAll(text, "}", "}}"))
+			continue
+		}
+
+		builder.WriteString("{" + part.Expression.String())
+		if part.Spec != "" {
+			builder.WriteString(":" + part.Spec)
+		}
+
+		builder.WriteString("}")
+	}
+
+	return fmt.Sprintf("ф\"%s\"", builder.String())
+}
+
 func (node *Literal) String() string {
 	switch {
 	case node.Nil:
 		return "нуль"
+	case node.Imaginary != nil:
+		return *node.Imaginary + "і"
 	case node.Integer != nil:
 		return *node.Integer
 	case node.Real != nil:
@@ -158,6 +223,8 @@ func (node *Literal) String() string {
 		}
 
 		return "хиба"
+	case node.FormattedString != nil:
+		return node.FormattedString.String()
 	case node.StringValue != nil:
 		return fmt.Sprintf("\"%s\"", *node.StringValue)
 	case node.MultilineString != nil:
@@ -174,7 +241,7 @@ func (node *Literal) String() string {
 	case node.Dictionary != nil:
 		var values []string
 		for _, entry := range node.Dictionary {
-			values = append(values, fmt.Sprintf("%s: %s", entry.Key.String(), entry.Value.String()))
+			values = append(values, entry.String())
 		}
 
 		return "{" + strings.Join(values, ", ") + "}"
@@ -188,6 +255,10 @@ func (node *Literal) String() string {
 }
 
 func (node *DictionaryEntry) String() string {
+	if node.Value == nil {
+		return node.Key.String()
+	}
+
 	return fmt.Sprintf("%s: %s", node.Key.String(), node.Value.String())
 }
 
@@ -199,6 +270,10 @@ func (node *LambdaDef) String() string {
 func (node *AttributeAccess) String() string {
 	str := node.IdentOrCall.String()
 	if node.AttributeAccess != nil {
+		if node.IsNilSafe {
+			str += "?"
+		}
+
 		str += "." + node.AttributeAccess.String()
 	}
 
@@ -285,9 +360,18 @@ func (node *LoopStmt) String() string {
 }
 
 func (node *RangeBasedLoop) String() string {
+	var variables []string
+	for _, variable := range node.Variables {
+		variables = append(variables, variable.String())
+	}
+
+	if node.RightBound == nil {
+		return fmt.Sprintf("(%s : %s)", strings.Join(variables, ", "), node.LeftBound.String())
+	}
+
 	return fmt.Sprintf(
 		"(%s : %s %s %s)",
-		node.Variable,
+		strings.Join(variables, ", "),
 		node.LeftBound.String(),
 		node.Separator,
 		node.RightBound.String(),
@@ -24,24 +24,68 @@ func init() {
 		},
 	)
 
+	types.StringClass.AddAttributes(types.MakeStringClassMethods(BuiltinPackage))
+	types.ListClass.AddAttributes(types.MakeListClassMethods(BuiltinPackage))
+	types.ComplexClass.AddAttributes(types.MakeComplexClassMethods(BuiltinPackage))
+	types.FractionClass.AddAttributes(types.MakeFractionClassMethods(BuiltinPackage))
+	types.DecimalClass.AddAttributes(types.MakeDecimalClassMethods(BuiltinPackage))
+	types.DateTimeClass.AddAttributes(types.MakeDateTimeClassMethods(BuiltinPackage))
+	types.DurationClass.AddAttributes(types.MakeDurationClassMethods(BuiltinPackage))
+	types.RegexpClass.AddAttributes(types.MakeRegexpClassMethods(BuiltinPackage))
+	types.MatchClass.AddAttributes(types.MakeMatchClassMethods(BuiltinPackage))
+	types.GeneratorClass.AddAttributes(types.MakeGeneratorClassMethods(BuiltinPackage))
+	types.FileClass.AddAttributes(types.MakeFileClassMethods(BuiltinPackage))
+	types.SetClass.AddAttributes(types.MakeSetClassMethods(BuiltinPackage))
+	types.FrozenSetClass.AddAttributes(types.MakeFrozenSetClassMethods(BuiltinPackage))
+	types.DequeClass.AddAttributes(types.MakeDequeClassMethods(BuiltinPackage))
+	types.QueueClass.AddAttributes(types.MakeQueueClassMethods(BuiltinPackage))
+	types.PriorityQueueClass.AddAttributes(types.MakePriorityQueueClassMethods(BuiltinPackage))
+	types.OrderedDictClass.AddAttributes(types.MakeOrderedDictClassMethods(BuiltinPackage))
+	types.CounterClass.AddAttributes(types.MakeCounterClassMethods(BuiltinPackage))
+	types.DefaultDictClass.AddAttributes(types.MakeDefaultDictClassMethods(BuiltinPackage))
+
 	types.ErrorClass.AddAttributes(types.MakeErrorClassMethods(BuiltinPackage))
 	types.ErrorClass.Operators = types.MakeErrorClassOperators(BuiltinPackage)
 
 	addMethod := methods.MakeAdd(BuiltinPackage)
 	assertMethod := methods.MakeAssert(BuiltinPackage)
+	copyMethod := methods.MakeCopy(BuiltinPackage)
+	deepCopyMethod := methods.MakeDeepCopy(BuiltinPackage)
+	enumerateMethod := methods.MakeEnumerate(BuiltinPackage)
+	filterMethod := methods.MakeFilter(BuiltinPackage)
+	formatMethod := methods.MakeFormat(BuiltinPackage)
+	hashMethod := methods.MakeHash(BuiltinPackage)
+	idMethod := methods.MakeId(BuiltinPackage)
+	inputMethod := methods.MakeInput(BuiltinPackage)
 	lenMethod := methods.MakeLen(BuiltinPackage)
+	mapMethod := methods.MakeMap(BuiltinPackage)
+	maxMethod := methods.MakeMax(BuiltinPackage)
+	minMethod := methods.MakeMin(BuiltinPackage)
+	printMethod := methods.MakePrint(BuiltinPackage)
+	printToMethod := methods.MakePrintTo(BuiltinPackage)
 	printlnMethod := methods.MakePrintln(BuiltinPackage)
+	reduceMethod := methods.MakeReduce(BuiltinPackage)
+	sortedMethod := methods.MakeSorted(BuiltinPackage)
+	sumMethod := methods.MakeSum(BuiltinPackage)
+	zipMethod := methods.MakeZip(BuiltinPackage)
 
 	GlobalScope = map[string]types.Object{
 		types.ObjectClass.Name: types.ObjectClass,
 		types.TypeClass.Name:   types.TypeClass,
 
-		types.BoolClass.Name:   types.BoolClass,
-		types.IntClass.Name:    types.IntClass,
-		types.ListClass.Name:   types.ListClass,
-		types.RealClass.Name:   types.RealClass,
-		types.StringClass.Name: types.StringClass,
-		types.TupleClass.Name:  types.TupleClass,
+		types.BoolClass.Name:       types.BoolClass,
+		types.ComplexClass.Name:    types.ComplexClass,
+		types.DecimalClass.Name:    types.DecimalClass,
+		types.DictionaryClass.Name: types.DictionaryClass,
+		types.FractionClass.Name:   types.FractionClass,
+		types.FrozenSetClass.Name:  types.FrozenSetClass,
+		types.IntClass.Name:        types.IntClass,
+		types.ListClass.Name:       types.ListClass,
+		types.RangeClass.Name:      types.RangeClass,
+		types.RealClass.Name:       types.RealClass,
+		types.SetClass.Name:        types.SetClass,
+		types.StringClass.Name:     types.StringClass,
+		types.TupleClass.Name:      types.TupleClass,
 
 		types.ErrorClass.Name:                types.ErrorClass,
 		types.RuntimeErrorClass.Name:         types.RuntimeErrorClass,
@@ -49,11 +93,46 @@ func init() {
 		types.AssertionErrorClass.Name:       types.AssertionErrorClass,
 		types.ZeroDivisionErrorClass.Name:    types.ZeroDivisionErrorClass,
 		types.IndexOutOfRangeErrorClass.Name: types.IndexOutOfRangeErrorClass,
+		types.KeyErrorClass.Name:             types.KeyErrorClass,
+		types.ValueErrorClass.Name:           types.ValueErrorClass,
+		types.OverflowErrorClass.Name:        types.OverflowErrorClass,
+		types.AttributeErrorClass.Name:       types.AttributeErrorClass,
+		types.IdentifierErrorClass.Name:      types.IdentifierErrorClass,
+		types.StopIterationErrorClass.Name:   types.StopIterationErrorClass,
+
+		types.IOErrorClass.Name:                types.IOErrorClass,
+		types.FileNotFoundErrorClass.Name:      types.FileNotFoundErrorClass,
+		types.FileExistsErrorClass.Name:        types.FileExistsErrorClass,
+		types.PermissionErrorClass.Name:        types.PermissionErrorClass,
+		types.IsADirectoryErrorClass.Name:      types.IsADirectoryErrorClass,
+		types.NotADirectoryErrorClass.Name:     types.NotADirectoryErrorClass,
+		types.DirectoryNotEmptyErrorClass.Name: types.DirectoryNotEmptyErrorClass,
+
+		addMethod.Name:       addMethod,
+		assertMethod.Name:    assertMethod,
+		copyMethod.Name:      copyMethod,
+		deepCopyMethod.Name:  deepCopyMethod,
+		enumerateMethod.Name: enumerateMethod,
+		filterMethod.Name:    filterMethod,
+		formatMethod.Name:    formatMethod,
+		hashMethod.Name:      hashMethod,
+		idMethod.Name:        idMethod,
+		inputMethod.Name:     inputMethod,
+		lenMethod.Name:       lenMethod,
+		mapMethod.Name:       mapMethod,
+		maxMethod.Name:       maxMethod,
+		minMethod.Name:       minMethod,
+		printMethod.Name:     printMethod,
+		printToMethod.Name:   printToMethod,
+		printlnMethod.Name:   printlnMethod,
+		reduceMethod.Name:    reduceMethod,
+		sortedMethod.Name:    sortedMethod,
+		sumMethod.Name:       sumMethod,
+		zipMethod.Name:       zipMethod,
 
-		addMethod.Name:     addMethod,
-		assertMethod.Name:  assertMethod,
-		lenMethod.Name:     lenMethod,
-		printlnMethod.Name: printlnMethod,
+		"стд_ввід":    types.Stdin,
+		"стд_вивід":   types.Stdout,
+		"стд_помилки": types.Stderr,
 
 		types.ErrorClass.Name:     types.ErrorClass,
 		types.TypeErrorClass.Name: types.TypeErrorClass,
@@ -60,9 +60,13 @@ func (i *InterpreterImpl) Import(newPackagePath string) (
 	error,
 ) {
 	parentPkg, _ := i.state.PackageOrNil().(*types.Package)
-	fullPackagePath, err := getFullPath(newPackagePath, parentPkg)
-	if err != nil {
-		return nil, err
+	fullPackagePath, makeAttributes := lookupNativePackage(newPackagePath)
+	if makeAttributes == nil {
+		var err error
+		fullPackagePath, err = getFullPath(newPackagePath, parentPkg)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if p, ok := i.packages[fullPackagePath]; ok {
@@ -78,6 +82,10 @@ func (i *InterpreterImpl) Import(newPackagePath string) (
 		currPackage = currPackage.Parent
 	}
 
+	if makeAttributes != nil {
+		return i.evaluateNative(fullPackagePath, makeAttributes, parentPkg), nil
+	}
+
 	packageCode, err := readFile(fullPackagePath)
 	if err != nil {
 		return nil, err
@@ -86,6 +94,21 @@ func (i *InterpreterImpl) Import(newPackagePath string) (
 	return i.Evaluate(fullPackagePath, string(packageCode), parentPkg)
 }
 
+// evaluateNative creates the package implemented in Go.
+func (i *InterpreterImpl) evaluateNative(
+	packageName string,
+	makeAttributes NativePackageFunc,
+	parentPkg *types.Package,
+) *types.Package {
+	pkg := types.PackageNew(packageName, parentPkg, i.rootContext.Derive())
+	for name, attr := range makeAttributes(pkg) {
+		pkg.Dict[name] = attr
+	}
+
+	i.packages[packageName] = pkg
+	return pkg
+}
+
 func (i *InterpreterImpl) Evaluate(packageName, code string, parentPkg *types.Package) (types.Object, error) {
 	ast, err := i.parser.Parse(packageName, code)
 	if err != nil {
@@ -1,6 +1,11 @@
 package interpreter
 
 import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/utilities"
 	"github.com/alecthomas/participle/v2"
 	"github.com/alecthomas/participle/v2/lexer"
 )
@@ -9,13 +14,14 @@ type ParserImpl struct {
 	parser *participle.Parser
 }
 
+var parserOptions = []participle.Option{
+	participle.UseLookahead(2),
+	participle.Unquote("String", "RawString", "Char"),
+	participle.Map(identMapper, "Ident"),
+}
+
 func NewParser() (*ParserImpl, error) {
-	parser, err := participle.Build(
-		&Package{},
-		participle.UseLookahead(2),
-		participle.Unquote("String", "RawString", "Char"),
-		participle.Map(identMapper, "Ident"),
-	)
+	parser, err := participle.Build(&Package{}, parserOptions...)
 	if err != nil {
 		return nil, err
 	}
@@ -33,6 +39,137 @@ func (p *ParserImpl) Parse(filename string, code string) (Evaluatable, error) {
 	return ast, nil
 }
 
+var (
+	expressionParser     *participle.Parser
+	expressionParserErr  error
+	expressionParserOnce sync.Once
+)
+
+func parseExpression(code string) (*Expression, error) {
+	expressionParserOnce.Do(
+		func() {
+			expressionParser, expressionParserErr = participle.Build(&Expression{}, parserOptions...)
+		},
+	)
+
+	if expressionParserErr != nil {
+		return nil, expressionParserErr
+	}
+
+	expression := &Expression{}
+	err := expressionParser.ParseString("", code, expression)
+	if err != nil {
+		return nil, err
+	}
+
+	return expression, nil
+}
+
+// parseFormattedString splits the contents of formatted string literal
+// into text and expression parts.
+func parseFormattedString(value string) ([]*FormattedStringPart, error) {
+	var parts []*FormattedStringPart
+	var text strings.Builder
+	source := []rune(value)
+	for i := 0; i < len(source); i++ {
+		switch source[i] {
+		case '{':
+			if i+1 < len(source) && source[i+1] == '{' {
+				text.WriteRune('{')
+				i++
+				continue
+			}
+
+			end, specStart, err := findFormattedStringHoleEnd(source, i+1)
+			if err != nil {
+				return nil, err
+			}
+
+			code := source[i+1 : end]
+			spec := ""
+			if specStart >= 0 {
+				code = source[i+1 : specStart]
+				spec = string(source[specStart+1 : end])
+			}
+
+			if strings.TrimSpace(string(code)) == "" {
+				return nil, utilities.SyntaxError("порожній вираз у форматованому рядку")
+			}
+
+			expression, err := parseExpression(string(code))
+			if err != nil {
+				return nil, utilities.SyntaxError(
+					fmt.Sprintf("некоректний вираз '%s' у форматованому рядку: %s", string(code), err.Error()),
+				)
+			}
+
+			if text.Len() != 0 {
+				parts = append(parts, &FormattedStringPart{Text: text.String()})
+				text.Reset()
+			}
+
+			parts = append(parts, &FormattedStringPart{Expression: expression, Spec: spec})
+			i = end
+		case '}':
+			if i+1 < len(source) && source[i+1] == '}' {
+				text.WriteRune('}')
+				i++
+				continue
+			}
+
+			return nil, utilities.SyntaxError("непарна закриваюча дужка '}' у форматованому рядку")
+		default:
+			text.WriteRune(source[i])
+		}
+	}
+
+	if text.Len() != 0 {
+		parts = append(parts, &FormattedStringPart{Text: text.String()})
+	}
+
+	return parts, nil
+}
+
+// findFormattedStringHoleEnd returns the index of closing curly brace of
+// an expression, which starts at the given index, and the index of colon,
+// which separates the format specifier, or -1 if there is no specifier.
+func findFormattedStringHoleEnd(source []rune, start int) (int, int, error) {
+	depth := 0
+	specStart := -1
+	var quote rune
+	for i := start; i < len(source); i++ {
+		r := source[i]
+		switch {
+		case quote != 0:
+			if r == '\\' {
+				i++
+			} else if r == quote {
+				quote = 0
+			}
+		case specStart >= 0:
+			if r == '}' {
+				return i, specStart, nil
+			}
+		case r == '"' || r == '\'' || r == '`':
+			quote = r
+		case r == '(' || r == '[' || r == '{':
+			depth++
+		case r == ')' || r == ']':
+			depth--
+		case r == '}':
+			if depth == 0 {
+				return i, specStart, nil
+			}
+
+			depth--
+		case r == ':' && depth == 0:
+			specStart = i
+		}
+	}
+
+	return 0, 0, utilities.SyntaxError("відсутня закриваюча дужка '}' у форматованому рядку")
+}
+
 var runes = map[rune]rune{
 	'a': 'а',
 	'c': 'с',
@@ -0,0 +1,77 @@
+package interpreter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/packages"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+)
+
+// NativePackageFunc creates attributes of the package implemented in
+// Go. Functions of the package should be created by types.FunctionNew
+// with the given package.
+type NativePackageFunc func(pkg *types.Package) types.StringDict
+
+var nativePackages = map[string]NativePackageFunc{}
+
+// RegisterPackage makes the package implemented in Go available for
+// import by its name with the "!/" prefix, i.e. імпорт("!/математика").
+// Registered packages are resolved before the files of the standard
+// library. It panics if a package with the same name is registered.
+func RegisterPackage(name string, makeAttributes NativePackageFunc) {
+	if makeAttributes == nil {
+		panic("makeAttributes is nil")
+	}
+
+	name = nativePackageName(name)
+	if _, ok := nativePackages[name]; ok {
+		panic(fmt.Sprintf("package '%s' is already registered", name))
+	}
+
+	nativePackages[name] = makeAttributes
+}
+
+func nativePackageName(packagePath string) string {
+	packagePath = strings.TrimPrefix(packagePath, "!/")
+	return strings.TrimSuffix(packagePath, "."+builtin.LANGUAGE_FILE_EXT)
+}
+
+// lookupNativePackage returns the full path of the registered package
+// and the function which creates its attributes, or nil if the path
+// does not refer to a registered package.
+func lookupNativePackage(packagePath string) (string, NativePackageFunc) {
+	if !strings.HasPrefix(packagePath, "!/") {
+		return "", nil
+	}
+
+	name := nativePackageName(packagePath)
+	if makeAttributes, ok := nativePackages[name]; ok {
+		return "!/" + name, makeAttributes
+	}
+
+	return "", nil
+}
+
+func init() {
+	RegisterPackage(packages.CollectionsPackageName, packages.MakeCollections)
+	RegisterPackage(packages.ComplexMathPackageName, packages.MakeComplexMath)
+	RegisterPackage(packages.FilesPackageName, packages.MakeFiles)
+	RegisterPackage(packages.JSONPackageName, packages.MakeJSON)
+	RegisterPackage(packages.MathPackageName, packages.MakeMath)
+	RegisterPackage(packages.PathPackageName, packages.MakePath)
+	RegisterPackage(packages.RandomPackageName, packages.MakeRandom)
+	RegisterPackage(packages.RegexpPackageName, packages.MakeRegexp)
+	RegisterPackage(packages.SystemPackageName, packages.MakeSystem)
+	RegisterPackage(packages.TimePackageName, packages.MakeTime)
+
+	// The former packages of the mathematics library, which are
+	// replaced by the native one.
+	RegisterPackage("математика/константи", packages.MakeMath)
+	RegisterPackage("математика/функції", packages.MakeMath)
+
+	// The former implementation of MT19937 in the library, the native
+	// one gives the same numbers.
+	RegisterPackage(packages.MT19937PackageName, packages.MakeMT19937)
+}
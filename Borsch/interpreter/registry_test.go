@@ -0,0 +1,66 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+func makeTestInterpreter(t *testing.T) Interpreter {
+	parser, err := NewParser()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewInterpreter(parser, NewInitialState(nil, nil, &common.StackTrace{}))
+}
+
+func TestRegisterPackage_Import(t *testing.T) {
+	calls := 0
+	RegisterPackage(
+		"тест/реєстр", func(pkg *types.Package) types.StringDict {
+			calls++
+			return types.StringDict{"значення": types.Int(42)}
+		},
+	)
+	defer delete(nativePackages, "тест/реєстр")
+
+	i := makeTestInterpreter(t)
+	first, err := i.Import("!/тест/реєстр")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkg, ok := first.(*types.Package)
+	if !ok {
+		t.Fatalf("Assertion failed:\nActual:\n%T\n\nExpected:\n*types.Package", first)
+	}
+
+	if pkg.Dict["значення"] != types.Int(42) {
+		t.Errorf("Assertion failed:\nActual:\n%v\n\nExpected:\n42", pkg.Dict["значення"])
+	}
+
+	second, err := i.Import("!/тест/реєстр.борщ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if second != first || calls != 1 {
+		t.Error("registered package is not cached")
+	}
+}
+
+func TestRegisterPackage_Duplicate(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("registering a package twice does not panic")
+		}
+	}()
+
+	RegisterPackage(
+		"!/математика", func(pkg *types.Package) types.StringDict {
+			return types.StringDict{}
+		},
+	)
+}
@@ -4,10 +4,12 @@ import "sort"
 
 var keywords = []string{
 	"блок",
+	"в",
 	"заключний",
 	"клас",
 	"кінець",
 	"лямбда",
+	"не",
 	"небезпечно",
 	"нуль",
 	"панікувати",
@@ -20,6 +22,7 @@ var keywords = []string{
 	"якщо",
 	"інакше",
 	"істина",
+	"є",
 }
 
 var builtinIds []string
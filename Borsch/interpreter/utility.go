@@ -55,6 +55,43 @@ func evalSlicingOperation(
 	valueToSet types.Object,
 ) (types.Object, error) {
 	switch iterable := variable.(type) {
+	case types.IMapping:
+		if ranges_[0].IsSlicing {
+			return nil, types.NewTypeErrorf(
+				"неможливо застосувати оператор зрізу до об'єкта з типом '%s'",
+				variable.Class().Name,
+			)
+		}
+
+		key, err := ranges_[0].LeftBound.Evaluate(state, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx := state.Context()
+		if len(ranges_) == 1 {
+			if valueToSet != nil {
+				return iterable.SetItem(ctx, key, valueToSet)
+			}
+
+			return iterable.GetItem(ctx, key)
+		}
+
+		element, err := iterable.GetItem(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		if valueToSet == nil {
+			return evalSlicingOperation(state, element, ranges_[1:], nil)
+		}
+
+		element, err = evalSlicingOperation(state, element, ranges_[1:], valueToSet)
+		if err != nil {
+			return nil, err
+		}
+
+		return iterable.SetItem(ctx, key, element)
 	case types.ISequence:
 		errMsg := ""
 		if ranges_[0].IsSlicing {
@@ -97,23 +134,29 @@ func evalSlicingOperation(
 				rightIdx = length + rightIdx
 			}
 
+			if len(ranges_) == 1 && valueToSet != nil {
+				return setSlice(ctx, iterable, leftIdx, rightIdx, valueToSet)
+			}
+
 			element, err = iterable.Slice(ctx, leftIdx, rightIdx)
 			if err != nil {
 				return nil, err
 			}
 
 			if len(ranges_) == 1 {
-				// valueToSet is ignored, return error maybe.
 				return element, nil
 			}
 		} else if ranges_[0].IsSlicing {
+			if len(ranges_) == 1 && valueToSet != nil {
+				return setSlice(ctx, iterable, leftIdx, length, valueToSet)
+			}
+
 			element, err = iterable.Slice(ctx, leftIdx, length)
 			if err != nil {
 				return nil, err
 			}
 
 			if len(ranges_) == 1 {
-				// valueToSet is ignored, return error maybe.
 				return element, nil
 			}
 		} else {
@@ -131,6 +174,10 @@ func evalSlicingOperation(
 			}
 		}
 
+		if valueToSet == nil {
+			return evalSlicingOperation(state, element, ranges_[1:], nil)
+		}
+
 		element, err = evalSlicingOperation(state, element, ranges_[1:], valueToSet)
 		if err != nil {
 			return nil, err
@@ -152,6 +199,22 @@ func evalSlicingOperation(
 	}
 }
 
+func setSlice(
+	ctx types.Context,
+	sequence types.ISequence,
+	leftIdx, rightIdx types.Int,
+	valueToSet types.Object,
+) (types.Object, error) {
+	if iterable, ok := sequence.(types.ISliceAssignment); ok {
+		return iterable.SetSlice(ctx, leftIdx, rightIdx, valueToSet)
+	}
+
+	return nil, types.NewTypeErrorf(
+		"об'єкт з типом '%s' не підтримує присвоєння зрізу",
+		sequence.(types.Object).Class().Name,
+	)
+}
+
 func mustInt(state State, expression *Expression, errFunc func(types.Object) error) (types.Int, error) {
 	value, err := expression.Evaluate(state, nil)
 	if err != nil {
@@ -307,7 +370,7 @@ func evalReturnTypes(state State, returnTypes []*ReturnType) ([]types.MethodRetu
 
 func getCurrentValue(ctx types.Context, prevValue types.Object, ident string) (types.Object, error) {
 	if prevValue != nil {
-		if err := checkForNilAttribute(ident); err != nil {
+		if err := checkForNilAttribute(prevValue, ident); err != nil {
 			return nil, err
 		}
 
@@ -322,7 +385,7 @@ func setCurrentValue(ctx types.Context, prevValue types.Object, ident string, va
 	error,
 ) {
 	if prevValue != nil {
-		if err := checkForNilAttribute(ident); err != nil {
+		if err := checkForNilAttribute(prevValue, ident); err != nil {
 			return nil, err
 		}
 
@@ -332,7 +395,14 @@ func setCurrentValue(ctx types.Context, prevValue types.Object, ident string, va
 	return valueToSet, ctx.SetVar(ident, valueToSet)
 }
 
-func checkForNilAttribute(ident string) error {
+func checkForNilAttribute(prevValue types.Object, ident string) error {
+	if prevValue == types.Nil {
+		return types.NewAttributeErrorf(
+			"неможливо отримати атрибут '%s' нульового значення, використовуйте '?.' для безпечного доступу",
+			ident,
+		)
+	}
+
 	switch ident {
 	case "нуль", "нульове":
 		return types.NewAttributeErrorf("'%s' не є атрибутом", ident)
@@ -136,6 +136,10 @@ func (e CallError) Error() string {
 	return e.err.Error()
 }
 
+func (e CallError) Unwrap() error {
+	return e.err
+}
+
 func (e CallError) Original() error {
 	return e.err
 }